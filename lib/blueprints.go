@@ -5,6 +5,13 @@ import (
 	"net/http"
 )
 
+type IBlueprint interface {
+	GetGroupByCategory(ctx context.Context) (BlueprintGroupByCategoryResponse, error)
+	GetByTemplateID(ctx context.Context, templateID string) (BlueprintByTemplateIdResponse, error)
+}
+
+// BlueprintService reads the pre-built workflow blueprints. Blueprints are
+// public, so the client may be created without an API key to use it.
 type BlueprintService service
 
 func (b *BlueprintService) GetGroupByCategory(ctx context.Context) (BlueprintGroupByCategoryResponse, error) {
@@ -40,3 +47,5 @@ func (b *BlueprintService) GetByTemplateID(ctx context.Context, templateID strin
 
 	return resp, nil
 }
+
+var _ IBlueprint = &BlueprintService{}
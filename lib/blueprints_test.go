@@ -7,10 +7,12 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"path/filepath"
 	"testing"
 
 	"github.com/novuhq/go-novu/lib"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -68,3 +70,17 @@ func TestBlueprintService_GetByTemplateID_Success(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, resp, expectedResponse)
 }
+
+func TestBlueprintService_GetGroupByCategory_WithoutApiKey(t *testing.T) {
+	httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		assert.Empty(t, req.Header.Get("Authorization"))
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(groupByCategory))
+	}))
+	defer httpServer.Close()
+
+	c := lib.NewAPIClient("", &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	_, err := c.BlueprintApi.GetGroupByCategory(context.Background())
+
+	require.NoError(t, err)
+}
@@ -0,0 +1,90 @@
+package lib
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// cachedResources are the API resources whose GET responses are cached when
+// Config.CacheTTL is set. They rarely change but are read on hot paths.
+var cachedResources = map[string]bool{
+	"workflows":    true,
+	"integrations": true,
+}
+
+type forceRefreshKey struct{}
+
+// ForceRefresh returns a context making the request it is used with bypass the
+// response cache. The fresh response is cached for the following requests.
+func ForceRefresh(ctx context.Context) context.Context {
+	return context.WithValue(ctx, forceRefreshKey{}, true)
+}
+
+type cacheEntry struct {
+	resource  string
+	body      []byte
+	expiresAt time.Time
+}
+
+// responseCache keeps response bodies by URL. Expired entries are replaced on
+// the next request for their URL.
+type responseCache struct {
+	ttl     time.Duration
+	entries sync.Map
+}
+
+func newResponseCache(ttl time.Duration) *responseCache {
+	if ttl <= 0 {
+		return nil
+	}
+	return &responseCache{ttl: ttl}
+}
+
+// resource returns the cached resource the request is for, if any.
+func (c *responseCache) resource(req *http.Request, backendURL string) (string, bool) {
+	if c == nil {
+		return "", false
+	}
+
+	path := strings.TrimPrefix(req.URL.String(), backendURL)
+	resource, _, _ := strings.Cut(strings.TrimPrefix(path, "/"), "/")
+	resource, _, _ = strings.Cut(resource, "?")
+
+	return resource, cachedResources[resource]
+}
+
+func (c *responseCache) get(req *http.Request) ([]byte, bool) {
+	if req.Context().Value(forceRefreshKey{}) != nil {
+		return nil, false
+	}
+
+	v, ok := c.entries.Load(req.URL.String())
+	if !ok {
+		return nil, false
+	}
+
+	entry := v.(cacheEntry)
+	if time.Now().After(entry.expiresAt) {
+		return nil, false
+	}
+
+	return entry.body, true
+}
+
+func (c *responseCache) set(req *http.Request, resource string, body []byte) {
+	c.entries.Store(req.URL.String(), cacheEntry{resource: resource, body: body, expiresAt: time.Now().Add(c.ttl)})
+}
+
+// invalidate drops every cached response of the resource, as a mutation may
+// change any of its listings.
+func (c *responseCache) invalidate(resource string) {
+	c.entries.Range(func(key, v any) bool {
+		if v.(cacheEntry).resource == resource {
+			c.entries.Delete(key)
+		}
+		return true
+	})
+}
@@ -0,0 +1,102 @@
+package lib_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/novuhq/go-novu/lib"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newCachingTestServer(t *testing.T, reads *int32) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			w.Write([]byte(`{}`))
+			return
+		}
+		atomic.AddInt32(reads, 1)
+		json.NewEncoder(w).Encode(lib.WorkflowListResponse{Page: 1, Data: []lib.Workflow{workflowResponse.Data}})
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestCache_ServesReadsUntilExpired(t *testing.T) {
+	var reads int32
+	server := newCachingTestServer(t, &reads)
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(server.URL), CacheTTL: 50 * time.Millisecond})
+
+	for i := 0; i < 3; i++ {
+		resp, err := c.WorkflowApi.GetWorkflows(ctx, 1, 10)
+		require.NoError(t, err)
+		assert.Equal(t, workflowId, resp.Data[0].Id)
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&reads))
+
+	_, err := c.WorkflowApi.GetWorkflows(ctx, 2, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&reads), "other pages are cached separately")
+
+	time.Sleep(60 * time.Millisecond)
+	_, err = c.WorkflowApi.GetWorkflows(ctx, 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&reads))
+}
+
+func TestCache_ForceRefresh(t *testing.T) {
+	var reads int32
+	server := newCachingTestServer(t, &reads)
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(server.URL), CacheTTL: time.Minute})
+
+	_, err := c.WorkflowApi.GetWorkflows(ctx, 1, 10)
+	require.NoError(t, err)
+	_, err = c.WorkflowApi.GetWorkflows(lib.ForceRefresh(ctx), 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&reads))
+}
+
+func TestCache_InvalidatedByMutations(t *testing.T) {
+	var reads int32
+	server := newCachingTestServer(t, &reads)
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(server.URL), CacheTTL: time.Minute})
+
+	_, err := c.WorkflowApi.GetWorkflows(ctx, 1, 10)
+	require.NoError(t, err)
+	_, err = c.IntegrationsApi.Delete(ctx, "integrationId")
+	require.NoError(t, err)
+	_, err = c.WorkflowApi.GetWorkflows(ctx, 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&reads), "integration mutations keep cached workflows")
+
+	_, err = c.WorkflowApi.UpdateWorkflowStatus(ctx, workflowId, false)
+	require.NoError(t, err)
+	_, err = c.WorkflowApi.GetWorkflows(ctx, 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&reads))
+}
+
+func TestCache_DisabledByDefault(t *testing.T) {
+	var reads int32
+	server := newCachingTestServer(t, &reads)
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(server.URL)})
+
+	_, err := c.WorkflowApi.GetWorkflows(ctx, 1, 10)
+	require.NoError(t, err)
+	_, err = c.WorkflowApi.GetWorkflows(ctx, 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&reads))
+}
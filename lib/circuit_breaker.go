@@ -0,0 +1,241 @@
+package lib
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sync/singleflight"
+)
+
+// ErrCircuitOpen is returned without calling the API while the circuit breaker
+// is open.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// CircuitBreakerConfig stops sending requests after repeated failures. A
+// failure is a network error or a 5xx response. Zero fields keep their default.
+type CircuitBreakerConfig struct {
+	ConsecutiveFailureThreshold int           // Failures in a row that open the circuit, defaults to 5
+	OpenDuration                time.Duration // How long the circuit stays open before probing, defaults to 30s
+	HalfOpenMaxRequests         int           // Probe requests let through while half-open, defaults to 1
+}
+
+const (
+	defaultConsecutiveFailureThreshold = 5
+	defaultOpenDuration                = 30 * time.Second
+	defaultHalfOpenMaxRequests         = 1
+)
+
+type circuitState int
+
+const (
+	circuitClosed circuitState = iota
+	circuitOpen
+	circuitHalfOpen
+)
+
+// circuitBreaker is shared by every copy of the APIClient. A nil breaker lets
+// all requests through.
+type circuitBreaker struct {
+	threshold   int
+	openFor     time.Duration
+	maxHalfOpen int
+	now         func() time.Time
+	probes      singleflight.Group
+
+	mu       sync.Mutex
+	state    circuitState
+	failures int
+	openedAt time.Time
+	admitted int
+}
+
+func newCircuitBreaker(cfg *CircuitBreakerConfig) *circuitBreaker {
+	if cfg == nil {
+		return nil
+	}
+	return &circuitBreaker{
+		threshold:   valueOrDefault(cfg.ConsecutiveFailureThreshold, defaultConsecutiveFailureThreshold),
+		openFor:     valueOrDefault(cfg.OpenDuration, defaultOpenDuration),
+		maxHalfOpen: valueOrDefault(cfg.HalfOpenMaxRequests, defaultHalfOpenMaxRequests),
+		now:         time.Now,
+	}
+}
+
+// do sends the request unless the circuit is open. While half-open, the first
+// admitted request probes the API through singleflight and the others admitted,
+// up to HalfOpenMaxRequests in all, wait for the probe to finish: they are only
+// sent once it succeeded. Waiters share the probe's outcome, never its response,
+// and stop waiting when their own ctx is done.
+func (b *circuitBreaker) do(ctx context.Context, send func() (*http.Response, error)) (*http.Response, error) {
+	if b == nil {
+		return send()
+	}
+
+	halfOpen, err := b.allow()
+	if err != nil {
+		return nil, err
+	}
+
+	if halfOpen {
+		if res, proceed, err := b.probe(ctx, send); !proceed {
+			return res, err
+		}
+	}
+
+	res, err := send()
+	b.record(res, err)
+	return res, err
+}
+
+// errProbeAbandoned is the outcome of a probe whose caller was done before it
+// could be sent.
+var errProbeAbandoned = errors.New("circuit breaker probe abandoned")
+
+// probeCall is the probe a caller would send, if singleflight runs its call.
+type probeCall struct {
+	mu        sync.Mutex
+	started   bool
+	abandoned bool
+
+	done chan struct{}
+	res  *http.Response
+	err  error
+}
+
+// probe waits for the half-open probe, sending it when no other request does.
+// It returns the response of the caller's own request when that was the probe.
+// proceed is set when another request probed the API successfully, so the
+// caller's request is to be sent now.
+func (b *circuitBreaker) probe(ctx context.Context, send func() (*http.Response, error)) (res *http.Response, proceed bool, err error) {
+	call := &probeCall{done: make(chan struct{})}
+	outcome := b.probes.DoChan("probe", func() (interface{}, error) {
+		call.mu.Lock()
+		if call.abandoned {
+			call.mu.Unlock()
+			return nil, errProbeAbandoned
+		}
+		call.started = true
+		call.mu.Unlock()
+
+		call.res, call.err = send()
+		b.record(call.res, call.err)
+		close(call.done)
+		return nil, probeOutcome(call.res, call.err)
+	})
+
+	select {
+	case <-call.done:
+		return call.res, false, call.err
+	case result := <-outcome:
+		call.mu.Lock()
+		started := call.started
+		call.mu.Unlock()
+		switch {
+		case started:
+			return call.res, false, call.err
+		case errors.Is(result.Err, context.Canceled) || errors.Is(result.Err, errProbeAbandoned):
+			// the probe says nothing about the API, take part in the next one
+			b.release()
+			res, err := b.do(ctx, send)
+			return res, false, err
+		case result.Err != nil:
+			return nil, false, ErrCircuitOpen
+		case ctx.Err() != nil:
+			b.release()
+			return nil, false, ctx.Err()
+		}
+		return nil, true, nil
+	case <-ctx.Done():
+		call.mu.Lock()
+		call.abandoned = true
+		started := call.started
+		call.mu.Unlock()
+		if started {
+			// the probe is sent with ctx, so it ends shortly and record frees its slot
+			<-call.done
+			return call.res, false, call.err
+		}
+		b.release()
+		return nil, false, ctx.Err()
+	}
+}
+
+// probeOutcome is nil when the probe closed the circuit.
+func probeOutcome(res *http.Response, err error) error {
+	if errors.Is(err, context.Canceled) {
+		return err
+	}
+	if failed(res, err) {
+		return ErrCircuitOpen
+	}
+	return nil
+}
+
+// allow reports whether a request may be sent, and whether it was admitted
+// while half-open. Once OpenDuration has passed the circuit turns half-open and
+// admits up to HalfOpenMaxRequests requests.
+func (b *circuitBreaker) allow() (bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == circuitOpen && b.now().Sub(b.openedAt) >= b.openFor {
+		b.state = circuitHalfOpen
+		b.admitted = 0
+	}
+
+	switch b.state {
+	case circuitOpen:
+		return false, ErrCircuitOpen
+	case circuitHalfOpen:
+		if b.admitted >= b.maxHalfOpen {
+			return false, ErrCircuitOpen
+		}
+		b.admitted++
+		return true, nil
+	}
+	return false, nil
+}
+
+// release frees the half-open slot of a request that was not sent.
+func (b *circuitBreaker) release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == circuitHalfOpen && b.admitted > 0 {
+		b.admitted--
+	}
+}
+
+// record updates the circuit with the outcome of a request let through by allow.
+// A success closes a half-open circuit and a failure opens it again. Requests
+// canceled by the caller say nothing about the API and only free their slot.
+func (b *circuitBreaker) record(res *http.Response, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if errors.Is(err, context.Canceled) {
+		if b.state == circuitHalfOpen && b.admitted > 0 {
+			b.admitted--
+		}
+		return
+	}
+
+	if !failed(res, err) {
+		b.state = circuitClosed
+		b.failures = 0
+		return
+	}
+
+	b.failures++
+	if b.state == circuitHalfOpen || b.failures >= b.threshold {
+		b.state = circuitOpen
+		b.openedAt = b.now()
+	}
+}
+
+func failed(res *http.Response, err error) bool {
+	return err != nil || (res != nil && res.StatusCode >= http.StatusInternalServerError)
+}
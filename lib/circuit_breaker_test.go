@@ -0,0 +1,222 @@
+package lib
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestCircuitBreaker_OpensAfterConsecutiveFailures(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	c := NewAPIClient("apiKey", &Config{
+		BackendURL:     MustParseURL(server.URL),
+		CircuitBreaker: &CircuitBreakerConfig{ConsecutiveFailureThreshold: 2, OpenDuration: time.Hour},
+	})
+
+	for i := 0; i < 2; i++ {
+		if _, err := c.SubscriberApi.Get(context.Background(), "subscriberId"); errors.Is(err, ErrCircuitOpen) {
+			t.Fatalf("request %d failed with ErrCircuitOpen before the threshold", i)
+		}
+	}
+
+	_, err := c.SubscriberApi.Get(context.Background(), "subscriberId")
+	if !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("Get() error = %v, want ErrCircuitOpen", err)
+	}
+	if requests != 2 {
+		t.Errorf("server received %d requests, want 2", requests)
+	}
+}
+
+func TestCircuitBreaker_HalfOpen(t *testing.T) {
+	now := time.Now()
+	b := newCircuitBreaker(&CircuitBreakerConfig{ConsecutiveFailureThreshold: 1, OpenDuration: time.Minute, HalfOpenMaxRequests: 2})
+	b.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	respond := func(res *http.Response, err error) func() (*http.Response, error) {
+		return func() (*http.Response, error) { return res, err }
+	}
+	failure := respond(&http.Response{StatusCode: http.StatusInternalServerError}, nil)
+	success := respond(&http.Response{StatusCode: http.StatusOK}, nil)
+
+	_, _ = b.do(ctx, failure)
+	if _, err := b.do(ctx, success); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("do() = %v while open, want ErrCircuitOpen", err)
+	}
+
+	now = now.Add(time.Minute)
+	if _, err := b.do(ctx, respond(nil, context.Canceled)); !errors.Is(err, context.Canceled) {
+		t.Fatalf("do() = %v, want the probe's context.Canceled", err)
+	}
+	if _, err := b.do(ctx, failure); err != nil {
+		t.Fatalf("do() = %v, a canceled probe should free its slot", err)
+	}
+	if _, err := b.do(ctx, success); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("do() = %v after a failed probe, want ErrCircuitOpen", err)
+	}
+
+	now = now.Add(time.Minute)
+	if _, err := b.do(ctx, success); err != nil {
+		t.Fatalf("do() = %v for a successful probe", err)
+	}
+	for i := 0; i < 5; i++ {
+		if _, err := b.do(ctx, success); err != nil {
+			t.Fatalf("do() = %v after a successful probe, want a closed circuit", err)
+		}
+	}
+}
+
+func TestCircuitBreaker_HalfOpenWaitsForProbe(t *testing.T) {
+	now := time.Now()
+	b := newCircuitBreaker(&CircuitBreakerConfig{ConsecutiveFailureThreshold: 1, OpenDuration: time.Minute, HalfOpenMaxRequests: 3})
+	b.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	_, _ = b.do(ctx, func() (*http.Response, error) { return &http.Response{StatusCode: http.StatusBadGateway}, nil })
+	now = now.Add(time.Minute)
+
+	release := make(chan struct{})
+	var mu sync.Mutex
+	var sent []string
+	send := func(name string, wait bool) func() (*http.Response, error) {
+		return func() (*http.Response, error) {
+			mu.Lock()
+			sent = append(sent, name)
+			mu.Unlock()
+			if wait {
+				<-release
+			}
+			return &http.Response{StatusCode: http.StatusOK}, nil
+		}
+	}
+
+	probeDone := make(chan struct{})
+	go func() {
+		defer close(probeDone)
+		if _, err := b.do(ctx, send("probe", true)); err != nil {
+			t.Errorf("probe failed: %v", err)
+		}
+	}()
+	for {
+		mu.Lock()
+		started := len(sent) == 1
+		mu.Unlock()
+		if started {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, 3)
+	for i, name := range []string{"first", "second", "rejected"} {
+		wg.Add(1)
+		go func(i int, name string) {
+			defer wg.Done()
+			_, errs[i] = b.do(ctx, send(name, false))
+		}(i, name)
+	}
+
+	// only the rejected request returns before the probe finishes
+	deadline := time.After(time.Second)
+	for {
+		b.mu.Lock()
+		joined := b.admitted == 3
+		b.mu.Unlock()
+		if joined {
+			break
+		}
+		select {
+		case <-deadline:
+			t.Fatal("the half-open requests did not wait for the probe")
+		case <-time.After(time.Millisecond):
+		}
+	}
+	mu.Lock()
+	if len(sent) != 1 {
+		t.Fatalf("sent %v while the probe was running, want only the probe", sent)
+	}
+	mu.Unlock()
+
+	close(release)
+	<-probeDone
+	wg.Wait()
+
+	if len(sent) != 3 {
+		t.Errorf("sent %v, want the probe and the two waiting requests", sent)
+	}
+	rejected := 0
+	for _, err := range errs {
+		if errors.Is(err, ErrCircuitOpen) {
+			rejected++
+		} else if err != nil {
+			t.Errorf("do() = %v", err)
+		}
+	}
+	if rejected != 1 {
+		t.Errorf("%d requests were rejected, want the one beyond HalfOpenMaxRequests", rejected)
+	}
+}
+
+func TestCircuitBreaker_HalfOpenWaiterHonorsItsContext(t *testing.T) {
+	now := time.Now()
+	b := newCircuitBreaker(&CircuitBreakerConfig{ConsecutiveFailureThreshold: 1, OpenDuration: time.Minute, HalfOpenMaxRequests: 2})
+	b.now = func() time.Time { return now }
+
+	_, _ = b.do(context.Background(), func() (*http.Response, error) { return &http.Response{StatusCode: http.StatusBadGateway}, nil })
+	now = now.Add(time.Minute)
+
+	release := make(chan struct{})
+	started := make(chan struct{})
+	probeDone := make(chan struct{})
+	go func() {
+		defer close(probeDone)
+		_, _ = b.do(context.Background(), func() (*http.Response, error) {
+			close(started)
+			<-release
+			return &http.Response{StatusCode: http.StatusOK}, nil
+		})
+	}()
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	done := make(chan error, 1)
+	go func() {
+		_, err := b.do(ctx, func() (*http.Response, error) {
+			t.Error("the waiter was sent after its context was done")
+			return nil, nil
+		})
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("do() = %v, want %v", err, context.DeadlineExceeded)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("the waiter stayed blocked on the probe after its deadline")
+	}
+
+	b.mu.Lock()
+	admitted := b.admitted
+	b.mu.Unlock()
+	if admitted != 1 {
+		t.Errorf("admitted = %d, want only the probe once the waiter gave up", admitted)
+	}
+
+	close(release)
+	<-probeDone
+}
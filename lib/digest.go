@@ -0,0 +1,132 @@
+package lib
+
+import (
+	"github.com/pkg/errors"
+)
+
+type DigestType string
+
+const (
+	DigestTypeRegular DigestType = "regular"
+	DigestTypeBackoff DigestType = "backoff"
+)
+
+type DigestUnit string
+
+const (
+	DigestUnitSeconds DigestUnit = "seconds"
+	DigestUnitMinutes DigestUnit = "minutes"
+	DigestUnitHours   DigestUnit = "hours"
+	DigestUnitDays    DigestUnit = "days"
+	DigestUnitWeeks   DigestUnit = "weeks"
+	DigestUnitMonths  DigestUnit = "months"
+)
+
+type DigestMetadata struct {
+	Type          DigestType `json:"type"`
+	Amount        int        `json:"amount"`
+	Unit          DigestUnit `json:"unit"`
+	DigestKey     string     `json:"digestKey,omitempty"`
+	Backoff       bool       `json:"backoff,omitempty"`
+	BackoffAmount int        `json:"backoffAmount,omitempty"`
+	BackoffUnit   DigestUnit `json:"backoffUnit,omitempty"`
+}
+
+// DigestStep is a workflow step that can be passed in CreateWorkflowRequest.Steps.
+type DigestStep struct {
+	Name     string         `json:"name,omitempty"`
+	Active   bool           `json:"active"`
+	Template StepTemplate   `json:"template"`
+	Metadata DigestMetadata `json:"metadata"`
+}
+
+// DigestStepBuilder builds a validated DigestStep. It defaults to a regular
+// digest.
+type DigestStepBuilder struct {
+	name     string
+	metadata DigestMetadata
+}
+
+func NewDigestStepBuilder() *DigestStepBuilder {
+	return &DigestStepBuilder{metadata: DigestMetadata{Type: DigestTypeRegular}}
+}
+
+func (b *DigestStepBuilder) SetName(name string) *DigestStepBuilder {
+	b.name = name
+	return b
+}
+
+func (b *DigestStepBuilder) SetDigestType(digestType DigestType) *DigestStepBuilder {
+	b.metadata.Type = digestType
+	return b
+}
+
+func (b *DigestStepBuilder) SetAmount(amount int) *DigestStepBuilder {
+	b.metadata.Amount = amount
+	return b
+}
+
+func (b *DigestStepBuilder) SetUnit(unit DigestUnit) *DigestStepBuilder {
+	b.metadata.Unit = unit
+	return b
+}
+
+// SetDigestKey groups digested events by the given payload key instead of by subscriber only.
+func (b *DigestStepBuilder) SetDigestKey(key string) *DigestStepBuilder {
+	b.metadata.DigestKey = key
+	return b
+}
+
+func (b *DigestStepBuilder) SetBackoffUnit(unit DigestUnit) *DigestStepBuilder {
+	b.metadata.BackoffUnit = unit
+	return b
+}
+
+func (b *DigestStepBuilder) SetBackoffAmount(amount int) *DigestStepBuilder {
+	b.metadata.BackoffAmount = amount
+	return b
+}
+
+// Build validates the configuration and returns the digest step. A backoff
+// digest requires both a backoff unit and amount.
+func (b *DigestStepBuilder) Build() (DigestStep, error) {
+	metadata := b.metadata
+
+	if metadata.Type != DigestTypeRegular && metadata.Type != DigestTypeBackoff {
+		return DigestStep{}, errors.Errorf("unsupported digest type %q", metadata.Type)
+	}
+	if metadata.Amount <= 0 {
+		return DigestStep{}, errors.New("digest amount must be greater than zero")
+	}
+	if !metadata.Unit.valid() {
+		return DigestStep{}, errors.Errorf("unsupported digest unit %q", metadata.Unit)
+	}
+
+	if metadata.Type == DigestTypeBackoff {
+		if metadata.BackoffAmount <= 0 || metadata.BackoffUnit == "" {
+			return DigestStep{}, errors.New("backoff digest requires both a backoff unit and amount")
+		}
+		if !metadata.BackoffUnit.valid() {
+			return DigestStep{}, errors.Errorf("unsupported backoff unit %q", metadata.BackoffUnit)
+		}
+		metadata.Backoff = true
+	} else {
+		metadata.BackoffAmount = 0
+		metadata.BackoffUnit = ""
+	}
+
+	return DigestStep{
+		Name:     b.name,
+		Active:   true,
+		Template: StepTemplate{Type: StepTypeDigest},
+		Metadata: metadata,
+	}, nil
+}
+
+func (u DigestUnit) valid() bool {
+	switch u {
+	case DigestUnitSeconds, DigestUnitMinutes, DigestUnitHours, DigestUnitDays, DigestUnitWeeks, DigestUnitMonths:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,90 @@
+package lib_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/novuhq/go-novu/lib"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDigestStepBuilder_Regular(t *testing.T) {
+	step, err := lib.NewDigestStepBuilder().
+		SetAmount(5).
+		SetUnit(lib.DigestUnitMinutes).
+		SetDigestKey("postId").
+		Build()
+
+	require.NoError(t, err)
+
+	body, _ := json.Marshal(step)
+	assert.JSONEq(t, `{
+		"active": true,
+		"template": {"type": "digest"},
+		"metadata": {"type": "regular", "amount": 5, "unit": "minutes", "digestKey": "postId"}
+	}`, string(body))
+}
+
+func TestDigestStepBuilder_Backoff(t *testing.T) {
+	step, err := lib.NewDigestStepBuilder().
+		SetDigestType(lib.DigestTypeBackoff).
+		SetAmount(1).
+		SetUnit(lib.DigestUnitHours).
+		SetBackoffAmount(10).
+		SetBackoffUnit(lib.DigestUnitMinutes).
+		Build()
+
+	require.NoError(t, err)
+	assert.Equal(t, lib.DigestMetadata{
+		Type:          lib.DigestTypeBackoff,
+		Amount:        1,
+		Unit:          lib.DigestUnitHours,
+		Backoff:       true,
+		BackoffAmount: 10,
+		BackoffUnit:   lib.DigestUnitMinutes,
+	}, step.Metadata)
+}
+
+func TestDigestStepBuilder_Validation(t *testing.T) {
+	tests := []struct {
+		name    string
+		builder *lib.DigestStepBuilder
+	}{
+		{
+			name:    "missing amount",
+			builder: lib.NewDigestStepBuilder().SetUnit(lib.DigestUnitMinutes),
+		},
+		{
+			name:    "unknown unit",
+			builder: lib.NewDigestStepBuilder().SetAmount(1).SetUnit("years"),
+		},
+		{
+			name:    "unknown type",
+			builder: lib.NewDigestStepBuilder().SetDigestType("timed").SetAmount(1).SetUnit(lib.DigestUnitDays),
+		},
+		{
+			name: "backoff without unit",
+			builder: lib.NewDigestStepBuilder().
+				SetDigestType(lib.DigestTypeBackoff).
+				SetAmount(1).
+				SetUnit(lib.DigestUnitDays).
+				SetBackoffAmount(5),
+		},
+		{
+			name: "backoff without amount",
+			builder: lib.NewDigestStepBuilder().
+				SetDigestType(lib.DigestTypeBackoff).
+				SetAmount(1).
+				SetUnit(lib.DigestUnitDays).
+				SetBackoffUnit(lib.DigestUnitHours),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.builder.Build()
+			require.Error(t, err)
+		})
+	}
+}
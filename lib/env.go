@@ -0,0 +1,42 @@
+package lib
+
+import (
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// NewClientFromEnv creates a client configured from the environment:
+//
+//	NOVU_API_KEY                 required
+//	NOVU_BACKEND_URL             self-hosted API URL, defaults to https://api.novu.co
+//	NOVU_IDEMPOTENCY_KEY_PREFIX  sets Config.IdempotencyKeyPrefix
+//	NOVU_TIMEOUT_SECONDS         sets Config.RequestTimeout
+func NewClientFromEnv() (*APIClient, error) {
+	apiKey := os.Getenv("NOVU_API_KEY")
+	if apiKey == "" {
+		return nil, errors.New("NOVU_API_KEY environment variable is not set")
+	}
+
+	cfg := &Config{IdempotencyKeyPrefix: os.Getenv("NOVU_IDEMPOTENCY_KEY_PREFIX")}
+
+	if rawURL := os.Getenv("NOVU_BACKEND_URL"); rawURL != "" {
+		backendURL, err := ParseBackendURL(rawURL)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid NOVU_BACKEND_URL")
+		}
+		cfg.BackendURL = backendURL
+	}
+
+	if rawTimeout := os.Getenv("NOVU_TIMEOUT_SECONDS"); rawTimeout != "" {
+		seconds, err := strconv.Atoi(rawTimeout)
+		if err != nil || seconds <= 0 {
+			return nil, errors.Errorf("invalid NOVU_TIMEOUT_SECONDS %q, expected a positive number of seconds", rawTimeout)
+		}
+		cfg.RequestTimeout = time.Duration(seconds) * time.Second
+	}
+
+	return NewAPIClient(apiKey, cfg), nil
+}
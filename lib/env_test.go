@@ -0,0 +1,70 @@
+package lib_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/novuhq/go-novu/lib"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewClientFromEnv(t *testing.T) {
+	var authorization, idempotencyKey string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		authorization = req.Header.Get("Authorization")
+		idempotencyKey = req.Header.Get("Idempotency-Key")
+		w.Write([]byte(`{"data":{"acknowledged":true,"status":"processed"}}`))
+	}))
+	defer server.Close()
+
+	t.Setenv("NOVU_API_KEY", novuApiKey)
+	t.Setenv("NOVU_BACKEND_URL", server.URL)
+	t.Setenv("NOVU_IDEMPOTENCY_KEY_PREFIX", "billing-")
+	t.Setenv("NOVU_TIMEOUT_SECONDS", "5")
+
+	c, err := lib.NewClientFromEnv()
+	require.NoError(t, err)
+
+	_, err = c.EventApi.Trigger(context.Background(), "event", lib.ITriggerPayloadOptions{To: "subscriberId"})
+	require.NoError(t, err)
+	assert.Equal(t, "ApiKey "+novuApiKey, authorization)
+	assert.True(t, strings.HasPrefix(idempotencyKey, "billing-"), idempotencyKey)
+}
+
+func TestNewClientFromEnv_Errors(t *testing.T) {
+	tests := map[string]struct {
+		env     map[string]string
+		wantErr string
+	}{
+		"missing api key": {
+			env:     map[string]string{"NOVU_API_KEY": ""},
+			wantErr: "NOVU_API_KEY environment variable is not set",
+		},
+		"invalid timeout": {
+			env:     map[string]string{"NOVU_API_KEY": novuApiKey, "NOVU_TIMEOUT_SECONDS": "soon"},
+			wantErr: `invalid NOVU_TIMEOUT_SECONDS "soon", expected a positive number of seconds`,
+		},
+		"invalid backend url": {
+			env:     map[string]string{"NOVU_API_KEY": novuApiKey, "NOVU_BACKEND_URL": "api.novu.co"},
+			wantErr: "invalid NOVU_BACKEND_URL",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			for _, key := range []string{"NOVU_BACKEND_URL", "NOVU_IDEMPOTENCY_KEY_PREFIX", "NOVU_TIMEOUT_SECONDS"} {
+				t.Setenv(key, "")
+			}
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+
+			_, err := lib.NewClientFromEnv()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
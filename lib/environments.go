@@ -0,0 +1,130 @@
+package lib
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+type IEnvironments interface {
+	GetCurrentEnvironment(ctx context.Context) (*EnvironmentResponse, error)
+	GetEnvironments(ctx context.Context) (*EnvironmentsResponse, error)
+	CreateEnvironment(ctx context.Context, name string, parentId string) (*EnvironmentResponse, error)
+	UpdateEnvironment(ctx context.Context, environmentId string, request UpdateEnvironmentRequest) (*EnvironmentResponse, error)
+	GetApiKeys(ctx context.Context) (*ApiKeysResponse, error)
+	RegenerateApiKey(ctx context.Context) (*ApiKeysResponse, error)
+}
+
+type EnvironmentService service
+
+func (e *EnvironmentService) GetCurrentEnvironment(ctx context.Context) (*EnvironmentResponse, error) {
+	var resp EnvironmentResponse
+	URL := e.client.config.BackendURL.JoinPath("environments", "me")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, URL.String(), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = e.client.sendRequest(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+func (e *EnvironmentService) GetEnvironments(ctx context.Context) (*EnvironmentsResponse, error) {
+	var resp EnvironmentsResponse
+	URL := e.client.config.BackendURL.JoinPath("environments")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, URL.String(), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = e.client.sendRequest(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+func (e *EnvironmentService) CreateEnvironment(ctx context.Context, name string, parentId string) (*EnvironmentResponse, error) {
+	var resp EnvironmentResponse
+	URL := e.client.config.BackendURL.JoinPath("environments")
+
+	jsonBody, _ := json.Marshal(CreateEnvironmentRequest{
+		Name:     name,
+		ParentId: parentId,
+	})
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, URL.String(), bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = e.client.sendRequest(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+func (e *EnvironmentService) UpdateEnvironment(ctx context.Context, environmentId string, request UpdateEnvironmentRequest) (*EnvironmentResponse, error) {
+	var resp EnvironmentResponse
+	URL := e.client.config.BackendURL.JoinPath("environments", environmentId)
+
+	jsonBody, _ := json.Marshal(request)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, URL.String(), bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = e.client.sendRequest(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+func (e *EnvironmentService) GetApiKeys(ctx context.Context) (*ApiKeysResponse, error) {
+	var resp ApiKeysResponse
+	URL := e.client.config.BackendURL.JoinPath("environments", "api-keys")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, URL.String(), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = e.client.sendRequest(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+func (e *EnvironmentService) RegenerateApiKey(ctx context.Context) (*ApiKeysResponse, error) {
+	var resp ApiKeysResponse
+	URL := e.client.config.BackendURL.JoinPath("environments", "api-keys", "regenerate")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, URL.String(), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = e.client.sendRequest(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+var _ IEnvironments = &EnvironmentService{}
@@ -0,0 +1,144 @@
+package lib_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/novuhq/go-novu/lib"
+	"github.com/stretchr/testify/require"
+)
+
+const environmentId = "6425cb40d22507199a000003"
+
+var environmentResponse = lib.EnvironmentResponse{
+	Data: lib.Environment{
+		Id:             environmentId,
+		Name:           "Development",
+		Identifier:     "dev-identifier",
+		OrganizationId: "orgId",
+		ApiKeys:        []lib.ApiKey{{Key: "api-key", UserId: "userId"}},
+		Dns:            &lib.EnvironmentDns{InboundParseDomain: "inbound.example.com", MxRecordConfigured: true},
+	},
+}
+
+func TestEnvironmentService_GetCurrentEnvironment_Success(t *testing.T) {
+	httpServer := createTestServer(t, TestServerOptions[io.Reader, lib.EnvironmentResponse]{
+		expectedURLPath:    "/v1/environments/me",
+		expectedSentMethod: http.MethodGet,
+		expectedSentBody:   http.NoBody,
+		responseStatusCode: http.StatusOK,
+		responseBody:       environmentResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.EnvironmentsApi.GetCurrentEnvironment(ctx)
+
+	require.NoError(t, err)
+	require.Equal(t, &environmentResponse, resp)
+}
+
+func TestEnvironmentService_GetEnvironments_Success(t *testing.T) {
+	expectedResponse := lib.EnvironmentsResponse{
+		Data: []lib.Environment{environmentResponse.Data},
+	}
+
+	httpServer := createTestServer(t, TestServerOptions[io.Reader, lib.EnvironmentsResponse]{
+		expectedURLPath:    "/v1/environments",
+		expectedSentMethod: http.MethodGet,
+		expectedSentBody:   http.NoBody,
+		responseStatusCode: http.StatusOK,
+		responseBody:       expectedResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.EnvironmentsApi.GetEnvironments(ctx)
+
+	require.NoError(t, err)
+	require.Equal(t, &expectedResponse, resp)
+}
+
+func TestEnvironmentService_CreateEnvironment_Success(t *testing.T) {
+	httpServer := createTestServer(t, TestServerOptions[lib.CreateEnvironmentRequest, lib.EnvironmentResponse]{
+		expectedURLPath:    "/v1/environments",
+		expectedSentMethod: http.MethodPost,
+		expectedSentBody:   lib.CreateEnvironmentRequest{Name: "Staging", ParentId: "parentId"},
+		responseStatusCode: http.StatusCreated,
+		responseBody:       environmentResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.EnvironmentsApi.CreateEnvironment(ctx, "Staging", "parentId")
+
+	require.NoError(t, err)
+	require.Equal(t, &environmentResponse, resp)
+}
+
+func TestEnvironmentService_UpdateEnvironment_Success(t *testing.T) {
+	updateEnvironmentRequest := lib.UpdateEnvironmentRequest{
+		Name: "Development",
+		Dns:  &lib.EnvironmentDns{InboundParseDomain: "inbound.example.com"},
+	}
+
+	httpServer := createTestServer(t, TestServerOptions[lib.UpdateEnvironmentRequest, lib.EnvironmentResponse]{
+		expectedURLPath:    fmt.Sprintf("/v1/environments/%s", environmentId),
+		expectedSentMethod: http.MethodPut,
+		expectedSentBody:   updateEnvironmentRequest,
+		responseStatusCode: http.StatusOK,
+		responseBody:       environmentResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.EnvironmentsApi.UpdateEnvironment(ctx, environmentId, updateEnvironmentRequest)
+
+	require.NoError(t, err)
+	require.Equal(t, &environmentResponse, resp)
+}
+
+func TestEnvironmentService_GetApiKeys_Success(t *testing.T) {
+	expectedResponse := lib.ApiKeysResponse{
+		Data: []lib.ApiKey{{Key: "api-key", UserId: "userId"}},
+	}
+
+	httpServer := createTestServer(t, TestServerOptions[io.Reader, lib.ApiKeysResponse]{
+		expectedURLPath:    "/v1/environments/api-keys",
+		expectedSentMethod: http.MethodGet,
+		expectedSentBody:   http.NoBody,
+		responseStatusCode: http.StatusOK,
+		responseBody:       expectedResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.EnvironmentsApi.GetApiKeys(ctx)
+
+	require.NoError(t, err)
+	require.Equal(t, &expectedResponse, resp)
+}
+
+func TestEnvironmentService_RegenerateApiKey_Success(t *testing.T) {
+	expectedResponse := lib.ApiKeysResponse{
+		Data: []lib.ApiKey{{Key: "new-api-key", UserId: "userId"}},
+	}
+
+	httpServer := createTestServer(t, TestServerOptions[io.Reader, lib.ApiKeysResponse]{
+		expectedURLPath:    "/v1/environments/api-keys/regenerate",
+		expectedSentMethod: http.MethodPost,
+		expectedSentBody:   http.NoBody,
+		responseStatusCode: http.StatusCreated,
+		responseBody:       expectedResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.EnvironmentsApi.RegenerateApiKey(ctx)
+
+	require.NoError(t, err)
+	require.Equal(t, &expectedResponse, resp)
+}
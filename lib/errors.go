@@ -0,0 +1,82 @@
+package lib
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// NovuAPIError is returned for every non-2xx response from the API. Use
+// errors.As to inspect the status code or the Novu error code.
+type NovuAPIError struct {
+	StatusCode int
+	Code       string
+	Message    string
+	Data       interface{}
+
+	// RateLimitLimit, RateLimitRemaining and RateLimitReset are read from the
+	// X-RateLimit-* response headers and are left zero when a header is absent.
+	RateLimitLimit     int
+	RateLimitRemaining int
+	RateLimitReset     time.Time
+
+	body string
+}
+
+func (e *NovuAPIError) Error() string {
+	return fmt.Sprintf("request was not successful, status code %d, %s", e.StatusCode, e.body)
+}
+
+type novuErrorBody struct {
+	StatusCode int         `json:"statusCode"`
+	Code       string      `json:"code"`
+	Error      string      `json:"error"`
+	Message    interface{} `json:"message"`
+	Data       interface{} `json:"data"`
+}
+
+func newNovuAPIError(statusCode int, header http.Header, body []byte) *NovuAPIError {
+	apiErr := &NovuAPIError{StatusCode: statusCode, body: string(body)}
+	apiErr.setRateLimit(header)
+
+	var decoded novuErrorBody
+	if err := json.Unmarshal(body, &decoded); err != nil {
+		apiErr.Message = string(body)
+		return apiErr
+	}
+
+	apiErr.Code = decoded.Code
+	if apiErr.Code == "" {
+		apiErr.Code = decoded.Error
+	}
+	apiErr.Data = decoded.Data
+
+	// validation errors carry a list of messages instead of a single one
+	switch message := decoded.Message.(type) {
+	case string:
+		apiErr.Message = message
+	case []interface{}:
+		messages := make([]string, 0, len(message))
+		for _, m := range message {
+			messages = append(messages, fmt.Sprint(m))
+		}
+		apiErr.Message = strings.Join(messages, "; ")
+	}
+
+	return apiErr
+}
+
+func (e *NovuAPIError) setRateLimit(header http.Header) {
+	if v, err := strconv.Atoi(header.Get("X-RateLimit-Limit")); err == nil {
+		e.RateLimitLimit = v
+	}
+	if v, err := strconv.Atoi(header.Get("X-RateLimit-Remaining")); err == nil {
+		e.RateLimitRemaining = v
+	}
+	if v, err := strconv.ParseInt(header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+		e.RateLimitReset = time.Unix(v, 0)
+	}
+}
@@ -0,0 +1,97 @@
+package lib_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/novuhq/go-novu/lib"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNovuAPIError_DecodesErrorBody(t *testing.T) {
+	httpServer := createTestServer(t, TestServerOptions[map[string]string, map[string]interface{}]{
+		expectedURLPath:    "/v1/subscribers/subscriberId",
+		expectedSentMethod: http.MethodGet,
+		expectedSentBody:   map[string]string{},
+		responseStatusCode: http.StatusNotFound,
+		responseBody: map[string]interface{}{
+			"statusCode": 404,
+			"message":    "Subscriber not found",
+			"error":      "Not Found",
+		},
+	})
+
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	_, err := c.SubscriberApi.Get(context.Background(), "subscriberId")
+
+	var apiErr *lib.NovuAPIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+	assert.Equal(t, "Not Found", apiErr.Code)
+	assert.Equal(t, "Subscriber not found", apiErr.Message)
+	assert.Contains(t, err.Error(), "status code 404")
+}
+
+func TestNovuAPIError_JoinsValidationMessages(t *testing.T) {
+	httpServer := createTestServer(t, TestServerOptions[map[string]string, map[string]interface{}]{
+		expectedURLPath:    "/v1/subscribers/subscriberId",
+		expectedSentMethod: http.MethodGet,
+		expectedSentBody:   map[string]string{},
+		responseStatusCode: http.StatusBadRequest,
+		responseBody: map[string]interface{}{
+			"statusCode": 400,
+			"message":    []string{"email must be an email", "phone must be a string"},
+			"error":      "Bad Request",
+		},
+	})
+
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	_, err := c.SubscriberApi.Get(context.Background(), "subscriberId")
+
+	var apiErr *lib.NovuAPIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+	assert.Equal(t, "email must be an email; phone must be a string", apiErr.Message)
+}
+
+func TestNovuAPIError_RateLimitUnwrapsToAPIError(t *testing.T) {
+	httpServer := createTestServer(t, TestServerOptions[map[string]string, map[string]interface{}]{
+		expectedURLPath:    "/v1/subscribers/subscriberId",
+		expectedSentMethod: http.MethodGet,
+		expectedSentBody:   map[string]string{},
+		responseStatusCode: http.StatusTooManyRequests,
+		responseBody:       map[string]interface{}{"statusCode": 429, "message": "ThrottlerException: Too Many Requests"},
+	})
+
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	_, err := c.SubscriberApi.Get(context.Background(), "subscriberId")
+
+	var apiErr *lib.NovuAPIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusTooManyRequests, apiErr.StatusCode)
+}
+
+func TestNovuAPIError_RateLimitHeaders(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "600")
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", "1700000000")
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"statusCode":429,"message":"ThrottlerException: Too Many Requests"}`))
+	}))
+	defer server.Close()
+
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(server.URL)})
+	_, err := c.EventApi.Trigger(context.Background(), "event", lib.ITriggerPayloadOptions{To: "subscriberId"})
+
+	var apiErr *lib.NovuAPIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, 600, apiErr.RateLimitLimit)
+	assert.Equal(t, 0, apiErr.RateLimitRemaining)
+	assert.True(t, time.Unix(1700000000, 0).Equal(apiErr.RateLimitReset))
+}
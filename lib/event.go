@@ -5,13 +5,23 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
 )
 
+// ErrNoSubscribers is returned when an event is triggered to an empty list of subscribers.
+var ErrNoSubscribers = errors.New("at least one subscriber is required")
+
 type IEvent interface {
 	Trigger(ctx context.Context, eventId string, data ITriggerPayloadOptions) (EventResponse, error)
+	CheckTrigger(ctx context.Context, eventId string, data ITriggerPayloadOptions) (TriggerCheckResult, error)
+	TriggerWithOverrides(ctx context.Context, eventId string, data ITriggerPayloadOptions, overrides ProviderOverrides) (EventResponse, error)
 	TriggerBulk(ctx context.Context, data []BulkTriggerOptions) ([]EventResponse, error)
 	BroadcastToAll(ctx context.Context, data BroadcastEventToAll) (EventResponse, error)
 	CancelTrigger(ctx context.Context, transactionId string) (bool, error)
+	ReplayEvent(ctx context.Context, transactionId string) (EventResponse, error)
+	TriggerToSubscribers(ctx context.Context, eventId string, subscribers []SubscriberPayload, payload map[string]interface{}) ([]EventResponse, error)
 }
 
 type EventService service
@@ -36,14 +46,86 @@ func (e *EventService) Trigger(ctx context.Context, eventId string, data ITrigge
 		return resp, err
 	}
 
-	_, err = e.client.sendRequest(req, &resp)
+	if data.IdempotencyKey != "" {
+		req.Header.Set("Idempotency-Key", data.IdempotencyKey)
+	}
+
+	res, err := e.client.sendRequest(req, &resp)
 	if err != nil {
 		return resp, err
 	}
 
+	resp.IsIdempotentReplay = res.Header.Get("Idempotency-Replayed") == "true"
+
 	return resp, nil
 }
 
+// CheckTrigger checks a trigger locally without sending it, since the API has no
+// dry-run mode. It only looks up the workflow with the eventId trigger
+// identifier, reporting whether it is active and which of its variables
+// Payload lacks. Subscribers, overrides and payload values are not validated,
+// and the trigger endpoint is never called, so nothing is sent and no
+// subscriber is created. An unknown trigger identifier fails.
+func (e *EventService) CheckTrigger(ctx context.Context, eventId string, data ITriggerPayloadOptions) (TriggerCheckResult, error) {
+	var result TriggerCheckResult
+
+	if eventId == "" {
+		return result, errors.New("event id is required")
+	}
+	if data.To == nil {
+		return result, ErrNoSubscribers
+	}
+
+	workflow, err := (*WorkflowService)(e).findByTriggerIdentifier(ctx, eventId)
+	if err != nil {
+		return result, err
+	}
+	if workflow == nil {
+		return result, errors.Errorf("workflow with trigger identifier %q not found", eventId)
+	}
+	result.WorkflowId = workflow.Id
+	result.Active = workflow.Active
+
+	var payload map[string]interface{}
+	jsonPayload, _ := json.Marshal(data.Payload)
+	_ = json.Unmarshal(jsonPayload, &payload)
+	for _, trigger := range workflow.Triggers {
+		if trigger.Identifier != eventId {
+			continue
+		}
+		for _, variable := range trigger.Variables {
+			if !hasPayloadValue(payload, variable.Name) {
+				result.MissingVariables = append(result.MissingVariables, variable.Name)
+			}
+		}
+	}
+	return result, nil
+}
+
+// hasPayloadValue reports whether the dotted path, e.g. "order.id", is set in payload.
+func hasPayloadValue(payload map[string]interface{}, path string) bool {
+	var value interface{} = payload
+	for _, key := range strings.Split(path, ".") {
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		if value, ok = m[key]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// TriggerWithOverrides triggers the event with the given provider overrides,
+// replacing data.Overrides, e.g. to send one email through another integration.
+func (e *EventService) TriggerWithOverrides(ctx context.Context, eventId string, data ITriggerPayloadOptions, overrides ProviderOverrides) (EventResponse, error) {
+	data.Overrides = overrides
+	return e.Trigger(ctx, eventId, data)
+}
+
+// TriggerBulk sends several events in a single call. Each event carries its
+// own workflow, recipients and payload, and gets its own response, in order.
 func (e *EventService) TriggerBulk(ctx context.Context, data []BulkTriggerOptions) ([]EventResponse, error) {
 	var resp []EventResponse
 	URL := e.client.config.BackendURL.JoinPath("events/trigger/bulk")
@@ -68,6 +150,20 @@ func (e *EventService) TriggerBulk(ctx context.Context, data []BulkTriggerOption
 
 }
 
+// TriggerToSubscribers sends a single event to every subscriber in the list
+// through one bulk trigger call, without creating a topic first.
+func (e *EventService) TriggerToSubscribers(ctx context.Context, eventId string, subscribers []SubscriberPayload, payload map[string]interface{}) ([]EventResponse, error) {
+	if len(subscribers) == 0 {
+		return nil, ErrNoSubscribers
+	}
+
+	return e.TriggerBulk(ctx, []BulkTriggerOptions{{
+		Name:    eventId,
+		To:      subscribers,
+		Payload: payload,
+	}})
+}
+
 func (e *EventService) BroadcastToAll(ctx context.Context, data BroadcastEventToAll) (EventResponse, error) {
 	var resp EventResponse
 	URL := e.client.config.BackendURL.JoinPath("events/trigger/broadcast")
@@ -112,4 +208,24 @@ func (e *EventService) CancelTrigger(ctx context.Context, transactionId string)
 	return resp, nil
 }
 
+// ReplayEvent re-sends a previously triggered event with its original payload.
+// The replayed event keeps the same transactionId, so Data.TransactionId on the
+// response matches the one passed in.
+func (e *EventService) ReplayEvent(ctx context.Context, transactionId string) (EventResponse, error) {
+	var resp EventResponse
+	URL := e.client.config.BackendURL.JoinPath("events", "trigger", transactionId, "replay")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, URL.String(), http.NoBody)
+	if err != nil {
+		return resp, err
+	}
+
+	_, err = e.client.sendRequest(req, &resp)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
 var _ IEvent = &EventService{}
@@ -0,0 +1,209 @@
+package lib
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrQueueStopped is returned by EventQueue.Enqueue once the queue has stopped.
+var ErrQueueStopped = errors.New("event queue stopped")
+
+const (
+	defaultFlushInterval     = time.Second
+	defaultQueueRetryWaitMin = time.Second
+	defaultQueueRetryWaitMax = 30 * time.Second
+)
+
+// EventQueue collects events and sends them in batches through
+// EventService.TriggerBulk, once batchSize events are queued or flushInterval
+// has passed, whichever comes first. Enqueue never blocks on the API, so events
+// keep queueing while a batch is being sent or retried.
+type EventQueue struct {
+	MaxRetries   int           // Retries of a failed batch before it is passed to OnFlushError
+	RetryWaitMin time.Duration // Wait before the first retry, doubled for every next one, defaults to 1s
+	RetryWaitMax time.Duration // Longest wait between retries, defaults to 30s
+
+	mu           sync.Mutex
+	events       []BulkTriggerOptions
+	batchSize    int
+	stopped      bool
+	onFlushError func(events []BulkTriggerOptions, err error)
+	full         chan struct{}
+	done         chan struct{}
+}
+
+func NewEventQueue() *EventQueue {
+	return &EventQueue{full: make(chan struct{}, 1), done: make(chan struct{})}
+}
+
+// Enqueue adds an event to the next batch. Events enqueued before Start are
+// sent once the queue starts. Once the queue has stopped, the event is not
+// queued and ErrQueueStopped is returned.
+func (q *EventQueue) Enqueue(event BulkTriggerOptions) error {
+	q.mu.Lock()
+	if q.stopped {
+		q.mu.Unlock()
+		return ErrQueueStopped
+	}
+	q.events = append(q.events, event)
+	full := q.batchSize > 0 && len(q.events) >= q.batchSize
+	q.mu.Unlock()
+
+	if full {
+		q.signalFull()
+	}
+	return nil
+}
+
+// OnFlushError sets the function called with a batch that could not be sent,
+// either because the API rejected it or because it still failed after
+// MaxRetries retries. Such batches are dropped when no function is set.
+func (q *EventQueue) OnFlushError(fn func(events []BulkTriggerOptions, err error)) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.onFlushError = fn
+}
+
+// Start sends the queued events in the background until ctx is done. The events
+// still queued then are sent once more before Wait returns. Canceling ctx does
+// not abort a batch that is being sent, since the API may already have accepted
+// it, but a failed batch is no longer retried and goes to OnFlushError instead.
+// batchSize is capped at MaxBulkTriggerEvents, and also defaults to it. Start
+// must be called once.
+func (q *EventQueue) Start(ctx context.Context, client *APIClient, batchSize int, flushInterval time.Duration) {
+	if batchSize <= 0 || batchSize > MaxBulkTriggerEvents {
+		batchSize = MaxBulkTriggerEvents
+	}
+
+	q.mu.Lock()
+	q.batchSize = batchSize
+	full := len(q.events) >= batchSize
+	q.mu.Unlock()
+
+	if full {
+		q.signalFull()
+	}
+
+	go q.run(ctx, client, valueOrDefault(flushInterval, defaultFlushInterval))
+}
+
+// Wait blocks until the queue has stopped and sent its remaining events.
+func (q *EventQueue) Wait() {
+	<-q.done
+}
+
+// Len returns the number of events waiting to be sent.
+func (q *EventQueue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.events)
+}
+
+func (q *EventQueue) run(ctx context.Context, client *APIClient, flushInterval time.Duration) {
+	defer close(q.done)
+
+	sendCtx := context.WithoutCancel(ctx)
+
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			q.mu.Lock()
+			q.stopped = true
+			q.mu.Unlock()
+			q.flush(sendCtx, ctx.Done(), client, true)
+			return
+		case <-ticker.C:
+			q.flush(sendCtx, ctx.Done(), client, true)
+		case <-q.full:
+			q.flush(sendCtx, ctx.Done(), client, false)
+		}
+	}
+}
+
+// flush sends full batches, and the last partial batch when all is set.
+func (q *EventQueue) flush(ctx context.Context, stop <-chan struct{}, client *APIClient, all bool) {
+	for {
+		batch := q.take(all)
+		if batch == nil {
+			return
+		}
+		q.send(ctx, stop, client, batch)
+	}
+}
+
+func (q *EventQueue) take(all bool) []BulkTriggerOptions {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	n := len(q.events)
+	if n == 0 || (!all && n < q.batchSize) {
+		return nil
+	}
+	if n > q.batchSize {
+		n = q.batchSize
+	}
+
+	batch := append([]BulkTriggerOptions(nil), q.events[:n]...)
+	q.events = append(q.events[:0], q.events[n:]...)
+	return batch
+}
+
+// send triggers the batch, retrying with exponential backoff until stop is
+// closed.
+func (q *EventQueue) send(ctx context.Context, stop <-chan struct{}, client *APIClient, batch []BulkTriggerOptions) {
+	wait := valueOrDefault(q.RetryWaitMin, defaultQueueRetryWaitMin)
+	maxWait := valueOrDefault(q.RetryWaitMax, defaultQueueRetryWaitMax)
+
+	for attempt := 0; ; attempt++ {
+		_, err := client.EventApi.TriggerBulk(ctx, batch)
+		if err == nil {
+			return
+		}
+		if attempt >= q.MaxRetries || !retryableFlushError(err) {
+			q.flushFailed(batch, err)
+			return
+		}
+
+		select {
+		case <-time.After(wait):
+		case <-stop:
+			q.flushFailed(batch, errors.Wrap(err, "event queue stopped before the batch was retried"))
+			return
+		}
+		wait = min(wait*2, maxWait)
+	}
+}
+
+func (q *EventQueue) flushFailed(batch []BulkTriggerOptions, err error) {
+	q.mu.Lock()
+	fn := q.onFlushError
+	q.mu.Unlock()
+
+	if fn != nil {
+		fn(batch, err)
+	}
+}
+
+func (q *EventQueue) signalFull() {
+	select {
+	case q.full <- struct{}{}:
+	default:
+	}
+}
+
+// retryableFlushError reports whether sending the batch again may succeed. The
+// API rejecting the batch itself, e.g. with a 400, is not retried.
+func retryableFlushError(err error) bool {
+	var apiErr *NovuAPIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == http.StatusTooManyRequests || apiErr.StatusCode >= http.StatusInternalServerError
+	}
+	return true
+}
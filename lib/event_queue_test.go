@@ -0,0 +1,166 @@
+package lib_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/novuhq/go-novu/lib"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventQueue_FlushesOnBatchSize(t *testing.T) {
+	var mu sync.Mutex
+	var batches []int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		require.Equal(t, "/v1/events/trigger/bulk", req.URL.Path)
+		var body lib.BulkTriggerEvent
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+
+		mu.Lock()
+		batches = append(batches, len(body.Events))
+		mu.Unlock()
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(server.URL)})
+	queue := lib.NewEventQueue()
+	for i := 0; i < 5; i++ {
+		queue.Enqueue(lib.BulkTriggerOptions{Name: "workflow", To: subscriberID})
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	queue.Start(ctx, c, 2, time.Hour)
+	require.Eventually(t, func() bool { return queue.Len() == 1 }, time.Second, time.Millisecond)
+
+	cancel()
+	queue.Wait()
+
+	assert.Equal(t, []int{2, 2, 1}, batches)
+	assert.Zero(t, queue.Len())
+}
+
+func TestEventQueue_FlushesOnInterval(t *testing.T) {
+	flushed := make(chan int, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var body lib.BulkTriggerEvent
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+		flushed <- len(body.Events)
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(server.URL)})
+	queue := lib.NewEventQueue()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	queue.Start(ctx, c, 100, 10*time.Millisecond)
+	queue.Enqueue(lib.BulkTriggerOptions{Name: "workflow", To: subscriberID})
+
+	select {
+	case n := <-flushed:
+		assert.Equal(t, 1, n)
+	case <-time.After(time.Second):
+		t.Fatal("the queue was not flushed after flushInterval")
+	}
+}
+
+func TestEventQueue_OnFlushError(t *testing.T) {
+	tests := map[string]struct {
+		statusCode   int
+		wantRequests int
+	}{
+		"server error is retried": {statusCode: http.StatusInternalServerError, wantRequests: 3},
+		"bad request is not":      {statusCode: http.StatusBadRequest, wantRequests: 1},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var mu sync.Mutex
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				mu.Lock()
+				requests++
+				mu.Unlock()
+				w.WriteHeader(tt.statusCode)
+			}))
+			defer server.Close()
+
+			c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(server.URL)})
+			queue := lib.NewEventQueue()
+			queue.MaxRetries = 2
+			queue.RetryWaitMin = time.Millisecond
+
+			failed := make(chan []lib.BulkTriggerOptions, 1)
+			queue.OnFlushError(func(events []lib.BulkTriggerOptions, err error) {
+				require.Error(t, err)
+				failed <- events
+			})
+
+			ctx, cancel := context.WithCancel(context.Background())
+			queue.Enqueue(lib.BulkTriggerOptions{Name: "workflow", To: subscriberID})
+			queue.Start(ctx, c, 1, time.Hour)
+
+			select {
+			case events := <-failed:
+				require.Len(t, events, 1)
+				assert.Equal(t, "workflow", events[0].Name)
+			case <-time.After(time.Second):
+				t.Fatal("OnFlushError was not called")
+			}
+
+			cancel()
+			queue.Wait()
+			assert.Equal(t, tt.wantRequests, requests)
+		})
+	}
+}
+
+func TestEventQueue_StopInterruptsRetryWait(t *testing.T) {
+	requested := make(chan struct{}, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		select {
+		case requested <- struct{}{}:
+		default:
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(server.URL)})
+	queue := lib.NewEventQueue()
+	queue.MaxRetries = 5
+	queue.RetryWaitMin = time.Hour
+
+	failed := make(chan error, 1)
+	queue.OnFlushError(func(events []lib.BulkTriggerOptions, err error) {
+		failed <- err
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	require.NoError(t, queue.Enqueue(lib.BulkTriggerOptions{Name: "workflow", To: subscriberID}))
+	queue.Start(ctx, c, 1, time.Hour)
+	<-requested
+
+	cancel()
+	stopped := make(chan struct{})
+	go func() {
+		queue.Wait()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatal("Wait blocked on the retry backoff")
+	}
+	require.ErrorContains(t, <-failed, "event queue stopped before the batch was retried")
+	require.ErrorIs(t, queue.Enqueue(lib.BulkTriggerOptions{Name: "workflow", To: subscriberID}), lib.ErrQueueStopped)
+	assert.Zero(t, queue.Len())
+}
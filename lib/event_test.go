@@ -265,3 +265,247 @@ func TestCancelTriggeredEvent_Success(t *testing.T) {
 		assert.Equal(t, expectedResponse, resp)
 	})
 }
+
+func TestEventServiceTrigger_ReturnsTransactionId(t *testing.T) {
+	const transactionId = "d2239acb-e879-4bdb-ab6f-365b43278d8f"
+	expectedResponse := lib.EventResponse{
+		Data: lib.EventResponseData{
+			Acknowledged:  true,
+			Status:        "processed",
+			TransactionId: transactionId,
+		},
+	}
+
+	httpServer := createTestServer(t, TestServerOptions[lib.EventRequest, lib.EventResponse]{
+		expectedURLPath:    "/v1/events/trigger",
+		expectedSentMethod: http.MethodPost,
+		expectedSentBody: lib.EventRequest{
+			Name:    novuEventId,
+			To:      "subscriberId",
+			Payload: map[string]interface{}{"name": "test"},
+		},
+		responseStatusCode: http.StatusCreated,
+		responseBody:       expectedResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.EventApi.Trigger(ctx, novuEventId, lib.ITriggerPayloadOptions{
+		To:      "subscriberId",
+		Payload: map[string]interface{}{"name": "test"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, expectedResponse, resp)
+	assert.Equal(t, transactionId, resp.Data.TransactionId)
+}
+
+func TestEventServiceTriggerToSubscribers_Success(t *testing.T) {
+	expectedResponse := []lib.EventResponse{{
+		Data: lib.EventResponseData{Acknowledged: true, Status: "processed"},
+	}}
+
+	httpServer := createTestServer(t, TestServerOptions[map[string]interface{}, []lib.EventResponse]{
+		expectedURLPath:    "/v1/events/trigger/bulk",
+		expectedSentMethod: http.MethodPost,
+		expectedSentBody: map[string]interface{}{
+			"events": []interface{}{
+				map[string]interface{}{
+					"name": novuEventId,
+					"to": []interface{}{
+						map[string]interface{}{"subscriberId": "first", "email": "first@example.com"},
+						map[string]interface{}{"subscriberId": "second"},
+					},
+					"payload": map[string]interface{}{"name": "test"},
+				},
+			},
+		},
+		responseStatusCode: http.StatusCreated,
+		responseBody:       expectedResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.EventApi.TriggerToSubscribers(ctx, novuEventId, []lib.SubscriberPayload{
+		{SubscriberId: "first", Email: "first@example.com"},
+		{SubscriberId: "second"},
+	}, map[string]interface{}{"name": "test"})
+
+	require.NoError(t, err)
+	assert.Equal(t, expectedResponse, resp)
+}
+
+func TestEventServiceTriggerToSubscribers_EmptySubscribers(t *testing.T) {
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL("http://127.0.0.1:0")})
+	resp, err := c.EventApi.TriggerToSubscribers(context.Background(), novuEventId, nil, nil)
+
+	require.ErrorIs(t, err, lib.ErrNoSubscribers)
+	assert.Nil(t, resp)
+}
+
+func TestEventServiceTrigger_IdempotencyKey(t *testing.T) {
+	const idempotencyKey = "order-42-shipped"
+	const transactionId = "d2239acb-e879-4bdb-ab6f-365b43278d8f"
+	requestCount := 0
+
+	httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requestCount++
+		assert.Equal(t, idempotencyKey, req.Header.Get("Idempotency-Key"))
+		if requestCount > 1 {
+			w.Header().Set("Idempotency-Replayed", "true")
+		}
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(lib.EventResponse{
+			Data: lib.EventResponseData{Acknowledged: true, Status: "processed", TransactionId: transactionId},
+		})
+	}))
+	defer httpServer.Close()
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	opts := lib.ITriggerPayloadOptions{To: "subscriberId", IdempotencyKey: idempotencyKey}
+
+	first, err := c.EventApi.Trigger(ctx, novuEventId, opts)
+	require.NoError(t, err)
+	assert.False(t, first.IsIdempotentReplay)
+
+	replay, err := c.EventApi.Trigger(ctx, novuEventId, opts)
+	require.NoError(t, err)
+	assert.True(t, replay.IsIdempotentReplay)
+	assert.Equal(t, first.Data.TransactionId, replay.Data.TransactionId)
+}
+
+func TestEventServiceTriggerWithOverrides_Success(t *testing.T) {
+	expectedResponse := lib.EventResponse{
+		Data: lib.EventResponseData{Acknowledged: true, Status: "processed"},
+	}
+
+	httpServer := createTestServer(t, TestServerOptions[map[string]interface{}, lib.EventResponse]{
+		expectedURLPath:    "/v1/events/trigger",
+		expectedSentMethod: http.MethodPost,
+		expectedSentBody: map[string]interface{}{
+			"name":    novuEventId,
+			"to":      "subscriberId",
+			"payload": map[string]interface{}{"name": "test"},
+			"overrides": map[string]interface{}{
+				"email":    map[string]interface{}{"integrationIdentifier": "sendgrid-eu"},
+				"sendgrid": map[string]interface{}{"templateId": "d-123"},
+			},
+		},
+		responseStatusCode: http.StatusCreated,
+		responseBody:       expectedResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.EventApi.TriggerWithOverrides(ctx, novuEventId, lib.ITriggerPayloadOptions{
+		To:      "subscriberId",
+		Payload: map[string]interface{}{"name": "test"},
+	}, lib.ProviderOverrides{
+		"email":    {"integrationIdentifier": "sendgrid-eu"},
+		"sendgrid": {"templateId": "d-123"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, expectedResponse, resp)
+}
+
+func TestEventServiceTrigger_WithActor(t *testing.T) {
+	expectedResponse := lib.EventResponse{
+		Data: lib.EventResponseData{Acknowledged: true, Status: "processed"},
+	}
+
+	httpServer := createTestServer(t, TestServerOptions[map[string]interface{}, lib.EventResponse]{
+		expectedURLPath:    "/v1/events/trigger",
+		expectedSentMethod: http.MethodPost,
+		expectedSentBody: map[string]interface{}{
+			"name":    novuEventId,
+			"to":      "subscriberId",
+			"payload": map[string]interface{}{"post": "Hello"},
+			"actor": map[string]interface{}{
+				"subscriberId": "alice",
+				"firstName":    "Alice",
+				"avatar":       "https://example.com/alice.png",
+			},
+		},
+		responseStatusCode: http.StatusCreated,
+		responseBody:       expectedResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.EventApi.Trigger(ctx, novuEventId, lib.ITriggerPayloadOptions{
+		To:      "subscriberId",
+		Payload: map[string]interface{}{"post": "Hello"},
+		Actor:   &lib.ActorPayload{SubscriberId: "alice", FirstName: "Alice", Avatar: "https://example.com/alice.png"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, expectedResponse, resp)
+}
+
+func TestEventServiceReplayEvent_Success(t *testing.T) {
+	const transactionId = "d2239acb-e879-4bdb-ab6f-365b43278d8f"
+	expectedResponse := lib.EventResponse{
+		Data: lib.EventResponseData{Acknowledged: true, Status: "processed", TransactionId: transactionId},
+	}
+
+	httpServer := createTestServer(t, TestServerOptions[io.Reader, lib.EventResponse]{
+		expectedURLPath:    "/v1/events/trigger/" + transactionId + "/replay",
+		expectedSentMethod: http.MethodPost,
+		expectedSentBody:   http.NoBody,
+		responseStatusCode: http.StatusCreated,
+		responseBody:       expectedResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.EventApi.ReplayEvent(ctx, transactionId)
+
+	require.NoError(t, err)
+	assert.Equal(t, expectedResponse, resp)
+	assert.Equal(t, transactionId, resp.Data.TransactionId)
+}
+
+func TestEventService_CheckTrigger(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requests = append(requests, req.Method+" "+req.URL.Path+"?page="+req.URL.Query().Get("page"))
+		if req.URL.Query().Get("page") == "0" {
+			json.NewEncoder(w).Encode(lib.WorkflowListResponse{TotalCount: 2, Data: []lib.Workflow{
+				{Active: true, Triggers: []lib.WorkflowTrigger{{Identifier: novuEventId + "-other"}}},
+			}})
+			return
+		}
+		json.NewEncoder(w).Encode(lib.WorkflowListResponse{TotalCount: 2, Data: []lib.Workflow{
+			{Id: "workflowId", Triggers: []lib.WorkflowTrigger{{
+				Identifier: novuEventId,
+				Variables:  []lib.WorkflowTriggerVariable{{Name: "name"}, {Name: "order.id"}},
+			}}},
+		}})
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(server.URL)})
+	result, err := c.EventApi.CheckTrigger(ctx, novuEventId, lib.ITriggerPayloadOptions{
+		To:      "subscriberId",
+		Payload: map[string]interface{}{"name": "John"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, lib.TriggerCheckResult{WorkflowId: "workflowId", MissingVariables: []string{"order.id"}}, result)
+	assert.Equal(t, []string{"GET /v1/workflows?page=0", "GET /v1/workflows?page=1"}, requests)
+}
+
+func TestEventService_CheckTriggerUnknownWorkflow(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(`{"data":[]}`))
+	}))
+	defer server.Close()
+
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(server.URL)})
+	_, err := c.EventApi.CheckTrigger(context.Background(), novuEventId, lib.ITriggerPayloadOptions{To: "subscriberId"})
+
+	require.EqualError(t, err, `workflow with trigger identifier "`+novuEventId+`" not found`)
+}
@@ -23,6 +23,21 @@ func (e *ExecutionsService) GetExecutions(ctx context.Context, q QueryBuilder) (
 	return resp, nil
 }
 
+func (e *ExecutionsService) GetExecutionDetails(ctx context.Context, notificationId string, subscriberId string) ([]ExecutionDetail, error) {
+	var resp ExecutionDetailsResponse
+	URL := e.client.config.BackendURL.JoinPath("execution-details")
+	URL.RawQuery = ExecutionsQueryParams{NotificationId: notificationId, SubscriberId: subscriberId}.BuildQuery()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, URL.String(), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	_, err = e.client.sendRequest(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
 func (q ExecutionsQueryParams) BuildQuery() string {
 	params := url.Values{}
 	if q.NotificationId != "" {
@@ -28,6 +28,7 @@ var executionsGetResponse = `{
 		"status": "Success",
 		"isTest": true,
 		"isRetry": true,
+		"raw": "{\"statusCode\":202}",
 		"createdAt": "string"
 	  }
 	]
@@ -58,3 +59,30 @@ func TestGetExecutions(t *testing.T) {
 		t.Error("Expected response, got none")
 	}
 }
+
+func TestGetExecutionDetails(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Want GET, got %s", r.Method)
+		}
+		expected := "/v1/execution-details?notificationId=12345&subscriberId=XYZ"
+		if r.URL.String() != expected {
+			t.Errorf("Want %s, got %s", expected, r.URL.String())
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(executionsGetResponse))
+	}))
+	defer server.Close()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(server.URL)})
+
+	details, err := c.ExecutionsApi.GetExecutionDetails(context.Background(), "12345", "XYZ")
+	if err != nil {
+		t.Errorf("Error should be nil, got %v", err)
+	}
+	if len(details) != 1 {
+		t.Fatalf("Want 1 execution detail, got %d", len(details))
+	}
+	if details[0].Status != "Success" || !details[0].IsRetry || details[0].Raw != `{"statusCode":202}` {
+		t.Errorf("Unexpected execution detail %+v", details[0])
+	}
+}
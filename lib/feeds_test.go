@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/novuhq/go-novu/lib"
@@ -20,6 +21,19 @@ var feedsApiResponse = `{
 }
 `
 
+var feedsListApiResponse = `{
+    "data": [
+        {
+            "_id": "string",
+            "name": "string",
+            "identifier": "string",
+            "_environmentId": "string",
+            "_organizationId": "string"
+        }
+    ]
+}
+`
+
 func TestCreateFeed(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodPost {
@@ -37,7 +51,7 @@ func TestCreateFeed(t *testing.T) {
 	if err != nil {
 		t.Errorf("Error should be nil, got %v", err)
 	}
-	if resp.Data == nil || resp.Data == "" {
+	if resp.Data.Id == "" || resp.Data.Identifier == "" {
 		t.Error("Expected response, got none")
 	}
 }
@@ -51,7 +65,7 @@ func TestGetFeeds(t *testing.T) {
 			t.Errorf("Want /v1/feeds, got %s", r.URL.Path)
 		}
 		w.Header().Set("Content-Type", "application/json")
-		w.Write([]byte(feedsApiResponse))
+		w.Write([]byte(feedsListApiResponse))
 	}))
 	defer server.Close()
 	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(server.URL)})
@@ -59,7 +73,7 @@ func TestGetFeeds(t *testing.T) {
 	if err != nil {
 		t.Errorf("Error should be nil, got %v", err)
 	}
-	if resp.Data == nil || resp.Data == "" {
+	if len(resp.Data) == 0 {
 		t.Error("Expected response, got none")
 	}
 }
@@ -73,7 +87,7 @@ func TestDeleteFeed(t *testing.T) {
 			t.Errorf("Want /v1/feeds/FeedId, got %s", r.URL.Path)
 		}
 		w.Header().Set("Content-Type", "application/json")
-		w.Write([]byte(feedsApiResponse))
+		w.Write([]byte(feedsListApiResponse))
 	}))
 	defer server.Close()
 	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(server.URL)})
@@ -81,7 +95,24 @@ func TestDeleteFeed(t *testing.T) {
 	if err != nil {
 		t.Errorf("Error should be nil, got %v", err)
 	}
-	if resp.Data == nil || resp.Data == "" {
+	if len(resp.Data) == 0 {
 		t.Error("Expected response, got none")
 	}
 }
+
+func TestDeleteFeed_SurfacesApiError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusConflict)
+		w.Write([]byte(`{"statusCode":409,"message":"Feed is used by active workflow steps"}`))
+	}))
+	defer server.Close()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(server.URL)})
+	_, err := c.FeedsApi.DeleteFeed(context.Background(), "FeedId")
+	if err == nil {
+		t.Fatal("Error should not be nil")
+	}
+	if !strings.Contains(err.Error(), "Feed is used by active workflow steps") {
+		t.Errorf("Want api error message in error, got %v", err)
+	}
+}
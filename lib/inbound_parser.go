@@ -6,8 +6,9 @@ import (
 )
 
 type IInboundParser interface {
-	Get(ctx context.Context) bool
+	Get(ctx context.Context) (*InboundParserResponse, error)
 }
+
 type InboundParserService service
 
 func (i InboundParserService) Get(ctx context.Context) (*InboundParserResponse, error) {
@@ -27,3 +28,5 @@ func (i InboundParserService) Get(ctx context.Context) (*InboundParserResponse,
 	}
 	return &resp, nil
 }
+
+var _ IInboundParser = &InboundParserService{}
@@ -13,6 +13,7 @@ type IIntegration interface {
 	GetActive(ctx context.Context) (*GetIntegrationsResponse, error)
 	GetWebhookSupportStatus(ctx context.Context, providerId string) (bool, error)
 	Update(ctx context.Context, integrationId string, request UpdateIntegrationRequest) (*IntegrationResponse, error)
+	UpdateCredentials(ctx context.Context, integrationId string, credentials IntegrationCredentials) (*IntegrationResponse, error)
 	Delete(ctx context.Context, integrationId string) (*IntegrationResponse, error)
 	SetIntegrationAsPrimary(ctx context.Context, integrationId string) (*SetIntegrationAsPrimaryResponse, error)
 	GetChannelLimit(ctx context.Context, channelType string) (*IntegrationChannelLimitResponse, error)
@@ -25,11 +26,12 @@ func (i IntegrationService) Create(ctx context.Context, request CreateIntegratio
 	URL := i.client.config.BackendURL.JoinPath("integrations")
 
 	requestBody := CreateIntegrationRequest{
-		ProviderID:  request.ProviderID,
-		Channel:     request.Channel,
-		Credentials: request.Credentials,
-		Active:      request.Active,
-		Check:       request.Check,
+		ProviderID:    request.ProviderID,
+		Channel:       request.Channel,
+		Credentials:   request.Credentials,
+		Active:        request.Active,
+		Check:         request.Check,
+		EnvironmentId: request.EnvironmentId,
 	}
 
 	jsonBody, _ := json.Marshal(requestBody)
@@ -132,6 +134,31 @@ func (i IntegrationService) Update(ctx context.Context, integrationId string, re
 	return &response, nil
 }
 
+func (i IntegrationService) UpdateCredentials(ctx context.Context, integrationId string, credentials IntegrationCredentials) (*IntegrationResponse, error) {
+	var response IntegrationResponse
+	URL := i.client.config.BackendURL.JoinPath("integrations", integrationId)
+
+	requestBody := UpdateIntegrationCredentialsRequest{
+		Credentials: credentials,
+	}
+
+	jsonBody, _ := json.Marshal(requestBody)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, URL.String(), bytes.NewBuffer(jsonBody))
+
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = i.client.sendRequest(req, &response)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
 func (i IntegrationService) Delete(ctx context.Context, integrationId string) (*IntegrationResponse, error) {
 	var response IntegrationResponse
 	URL := i.client.config.BackendURL.JoinPath("integrations", integrationId)
@@ -186,3 +213,5 @@ func (i IntegrationService) GetChannelLimit(ctx context.Context, channelType str
 
 	return &response, nil
 }
+
+var _ IIntegration = &IntegrationService{}
@@ -0,0 +1,118 @@
+package lib
+
+import "github.com/pkg/errors"
+
+type CredentialFieldType string
+
+const (
+	CredentialFieldString  CredentialFieldType = "string"
+	CredentialFieldSecret  CredentialFieldType = "secret"
+	CredentialFieldBoolean CredentialFieldType = "boolean"
+	CredentialFieldURL     CredentialFieldType = "url"
+)
+
+// CredentialField describes one credential of a provider. Name is the json key
+// of the matching IntegrationCredentials field.
+type CredentialField struct {
+	Name        string              `json:"name"`
+	Type        CredentialFieldType `json:"type"`
+	Required    bool                `json:"required"`
+	DisplayName string              `json:"displayName"`
+	Description string              `json:"description,omitempty"`
+}
+
+// CredentialSchema lists the credentials an integration with a provider takes.
+// Providers such as Discord or Microsoft Teams have no integration credentials
+// and an empty Fields.
+type CredentialSchema struct {
+	ProviderID ProviderIdType    `json:"providerId"`
+	Channel    ChannelType       `json:"channel"`
+	Fields     []CredentialField `json:"fields"`
+}
+
+func secretField(name, displayName string) CredentialField {
+	return CredentialField{Name: name, Type: CredentialFieldSecret, Required: true, DisplayName: displayName}
+}
+
+func stringField(name, displayName string, required bool) CredentialField {
+	return CredentialField{Name: name, Type: CredentialFieldString, Required: required, DisplayName: displayName}
+}
+
+func booleanField(name, displayName, description string) CredentialField {
+	return CredentialField{Name: name, Type: CredentialFieldBoolean, DisplayName: displayName, Description: description}
+}
+
+var (
+	apiKeyCredential     = secretField("apiKey", "API Key")
+	secretKeyCredential  = secretField("secretKey", "Secret Key")
+	regionCredential     = stringField("region", "Region", true)
+	smsFromCredential    = stringField("from", "From", true)
+	emailFromCredential  = CredentialField{Name: "from", Type: CredentialFieldString, Required: true, DisplayName: "From email address", Description: "The email address the messages are sent from"}
+	senderNameCredential = CredentialField{Name: "senderName", Type: CredentialFieldString, Required: true, DisplayName: "Sender name", Description: "The name shown as the sender of the messages"}
+)
+
+// credentialSchemas mirrors the provider credentials of the Novu dashboard, for
+// the providers whose credentials IntegrationCredentials can carry. It is kept
+// by hand and may lag behind providers or credentials added to Novu since.
+var credentialSchemas = map[ProviderIdType]CredentialSchema{
+	ProviderIDSendgrid:   {Channel: ChannelTypeEmail, Fields: []CredentialField{apiKeyCredential, emailFromCredential, senderNameCredential}},
+	ProviderIDMailgun:    {Channel: ChannelTypeEmail, Fields: []CredentialField{apiKeyCredential, stringField("user", "User name", true), stringField("domain", "Domain", true), emailFromCredential, senderNameCredential}},
+	ProviderIDMailjet:    {Channel: ChannelTypeEmail, Fields: []CredentialField{apiKeyCredential, secretKeyCredential, emailFromCredential, senderNameCredential}},
+	ProviderIDMandrill:   {Channel: ChannelTypeEmail, Fields: []CredentialField{apiKeyCredential, emailFromCredential, senderNameCredential}},
+	ProviderIDPostmark:   {Channel: ChannelTypeEmail, Fields: []CredentialField{apiKeyCredential, emailFromCredential, senderNameCredential}},
+	ProviderIDSES:        {Channel: ChannelTypeEmail, Fields: []CredentialField{secretField("apiKey", "Access Key ID"), secretField("secretKey", "Secret Access Key"), regionCredential, emailFromCredential, senderNameCredential}},
+	ProviderIDSendinblue: {Channel: ChannelTypeEmail, Fields: []CredentialField{apiKeyCredential, emailFromCredential, senderNameCredential}},
+	ProviderIDMailerSend: {Channel: ChannelTypeEmail, Fields: []CredentialField{apiKeyCredential, emailFromCredential, senderNameCredential}},
+	ProviderIDResend:     {Channel: ChannelTypeEmail, Fields: []CredentialField{apiKeyCredential, emailFromCredential, senderNameCredential}},
+	ProviderIDSparkPost:  {Channel: ChannelTypeEmail, Fields: []CredentialField{apiKeyCredential, {Name: "region", Type: CredentialFieldString, DisplayName: "Region", Description: "Set to eu for the EU region"}, emailFromCredential, senderNameCredential}},
+	ProviderIDOutlook365: {Channel: ChannelTypeEmail, Fields: []CredentialField{emailFromCredential, senderNameCredential, secretField("password", "Password")}},
+	ProviderIDSMTP: {Channel: ChannelTypeEmail, Fields: []CredentialField{
+		stringField("host", "Host", true),
+		stringField("port", "Port", true),
+		booleanField("secure", "Secure", "Connect with TLS right away instead of upgrading with STARTTLS"),
+		booleanField("requireTls", "Require TLS", "Fail when the server does not support STARTTLS"),
+		booleanField("ignoreTls", "Ignore TLS", "Do not upgrade the connection with STARTTLS"),
+		stringField("user", "User", false),
+		{Name: "password", Type: CredentialFieldSecret, DisplayName: "Password"},
+		emailFromCredential,
+		senderNameCredential,
+	}},
+	ProviderIDInfobipMail: {Channel: ChannelTypeEmail, Fields: []CredentialField{apiKeyCredential, emailFromCredential, senderNameCredential}},
+
+	ProviderIDTwilio:     {Channel: ChannelTypeSMS, Fields: []CredentialField{stringField("accountSid", "Account SID", true), secretField("token", "Auth Token"), smsFromCredential}},
+	ProviderIDNexmo:      {Channel: ChannelTypeSMS, Fields: []CredentialField{apiKeyCredential, secretField("secretKey", "API Secret"), smsFromCredential}},
+	ProviderIDPlivo:      {Channel: ChannelTypeSMS, Fields: []CredentialField{stringField("accountSid", "Auth ID", true), secretField("token", "Auth Token"), smsFromCredential}},
+	ProviderIDSNS:        {Channel: ChannelTypeSMS, Fields: []CredentialField{secretField("apiKey", "Access Key ID"), secretField("secretKey", "Secret Access Key"), regionCredential}},
+	ProviderIDTelnyx:     {Channel: ChannelTypeSMS, Fields: []CredentialField{apiKeyCredential, smsFromCredential, stringField("messageProfileId", "Message Profile ID", false)}},
+	ProviderIDTermii:     {Channel: ChannelTypeSMS, Fields: []CredentialField{apiKeyCredential, smsFromCredential}},
+	ProviderIDInfobipSMS: {Channel: ChannelTypeSMS, Fields: []CredentialField{apiKeyCredential, smsFromCredential}},
+
+	ProviderIDExpo:      {Channel: ChannelTypePush, Fields: []CredentialField{secretField("apiKey", "Access Token")}},
+	ProviderIDOneSignal: {Channel: ChannelTypePush, Fields: []CredentialField{stringField("applicationId", "Application ID", true), apiKeyCredential}},
+
+	ProviderIDDiscord:    {Channel: ChannelTypeChat},
+	ProviderIDMSTeams:    {Channel: ChannelTypeChat},
+	ProviderIDMattermost: {Channel: ChannelTypeChat},
+
+	ProviderIDNovu: {Channel: ChannelTypeInApp},
+}
+
+// IntegrationCredentialSchema returns the credentials an integration with the
+// provider takes on the channel. The API does not publish these, so they come
+// from a catalog built into the library, not from Novu: no request is sent, and
+// providers added to Novu after this release are missing. Providers whose
+// credentials IntegrationCredentials cannot carry, such as FCM or APNs, are not
+// in the catalog either and return an error.
+func IntegrationCredentialSchema(providerID ProviderIdType, channel ChannelType) (CredentialSchema, error) {
+	schema, ok := credentialSchemas[providerID]
+	if !ok {
+		return CredentialSchema{}, errors.Errorf("no credential schema for provider %q", providerID)
+	}
+	if schema.Channel != channel {
+		return CredentialSchema{}, errors.Errorf("provider %q does not support the %s channel", providerID, channel)
+	}
+
+	schema.ProviderID = providerID
+	schema.Fields = append([]CredentialField{}, schema.Fields...)
+	return schema, nil
+}
@@ -83,7 +83,7 @@ func IntegrationTestServer[T any](t *testing.T, options IntegrationServerOptions
 
 func TestCreateIntegration_Success(t *testing.T) {
 	createIntegrationRequest := lib.CreateIntegrationRequest{
-		ProviderID: "sendgrid",
+		ProviderID: lib.ProviderIDSendgrid,
 		Channel:    "email",
 		Credentials: lib.IntegrationCredentials{
 			ApiKey:    "api_key",
@@ -228,6 +228,38 @@ func TestUpdateIntegration_Success(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestUpdateIntegrationCredentials_Success(t *testing.T) {
+	const integrationId = "integrationId"
+
+	credentials := lib.IntegrationCredentials{
+		ApiKey: "rotated_api_key",
+	}
+
+	var response *lib.IntegrationResponse
+	fileToStruct(filepath.Join("../testdata", "integration_response.json"), &response)
+
+	httpServer := IntegrationTestServer(t, IntegrationServerOptions[lib.UpdateIntegrationCredentialsRequest]{
+		ExpectedRequest: IntegrationRequestDetails[lib.UpdateIntegrationCredentialsRequest]{
+			Url:    fmt.Sprintf("/v1/integrations/%s", integrationId),
+			Method: http.MethodPut,
+			Body:   lib.UpdateIntegrationCredentialsRequest{Credentials: credentials},
+		},
+		ExpectedResponse: IntegrationResponseDetails{
+			StatusCode: http.StatusOK,
+			Body:       response,
+		},
+	})
+
+	ctx := context.Background()
+	novuClient := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+
+	res, err := novuClient.IntegrationsApi.UpdateCredentials(ctx, integrationId, credentials)
+
+	assert.Equal(t, response, res)
+
+	require.NoError(t, err)
+}
+
 func TestDeleteActiveIntegration_Success(t *testing.T) {
 	const integrationId = "integrationId"
 
@@ -306,3 +338,49 @@ func TestGetChannelLimit_Success(t *testing.T) {
 	assert.Equal(t, response, res)
 	require.NoError(t, err)
 }
+
+func TestIntegrationCredentialSchema(t *testing.T) {
+	schema, err := lib.IntegrationCredentialSchema(lib.ProviderIDTwilio, lib.ChannelTypeSMS)
+	require.NoError(t, err)
+	assert.Equal(t, lib.ProviderIDTwilio, schema.ProviderID)
+	assert.Equal(t, lib.ChannelTypeSMS, schema.Channel)
+	assert.Equal(t, []lib.CredentialField{
+		{Name: "accountSid", Type: lib.CredentialFieldString, Required: true, DisplayName: "Account SID"},
+		{Name: "token", Type: lib.CredentialFieldSecret, Required: true, DisplayName: "Auth Token"},
+		{Name: "from", Type: lib.CredentialFieldString, Required: true, DisplayName: "From"},
+	}, schema.Fields)
+
+	_, err = lib.IntegrationCredentialSchema(lib.ProviderIDTwilio, lib.ChannelTypeEmail)
+	require.EqualError(t, err, `provider "twilio" does not support the email channel`)
+
+	_, err = lib.IntegrationCredentialSchema(lib.ProviderIDFCM, lib.ChannelTypePush)
+	require.EqualError(t, err, `no credential schema for provider "fcm"`)
+}
+
+func TestIntegrationCredentialSchema_FieldsMatchCredentials(t *testing.T) {
+	credentials, _ := json.Marshal(lib.IntegrationCredentials{
+		ApiKey: "x", User: "x", SecretKey: "x", Domain: "x", Password: "x", Host: "x", Port: "x", Secure: true,
+		Region: "x", AccountSID: "x", MessageProfileID: "x", Token: "x", From: "x", SenderName: "x",
+		ProjectName: "x", ApplicationID: "x", ClientID: "x", RequireTls: true, IgnoreTls: true,
+	})
+	var keys map[string]interface{}
+	require.NoError(t, json.Unmarshal(credentials, &keys))
+
+	providers := map[lib.ProviderIdType]lib.ChannelType{
+		lib.ProviderIDSendgrid:  lib.ChannelTypeEmail,
+		lib.ProviderIDMailgun:   lib.ChannelTypeEmail,
+		lib.ProviderIDSES:       lib.ChannelTypeEmail,
+		lib.ProviderIDSMTP:      lib.ChannelTypeEmail,
+		lib.ProviderIDTwilio:    lib.ChannelTypeSMS,
+		lib.ProviderIDTelnyx:    lib.ChannelTypeSMS,
+		lib.ProviderIDOneSignal: lib.ChannelTypePush,
+		lib.ProviderIDDiscord:   lib.ChannelTypeChat,
+	}
+	for providerID, channel := range providers {
+		schema, err := lib.IntegrationCredentialSchema(providerID, channel)
+		require.NoError(t, err, providerID)
+		for _, field := range schema.Fields {
+			assert.Contains(t, keys, field.Name, "%s field %s is not an IntegrationCredentials key", providerID, field.Name)
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"strconv"
 )
 
 type LayoutService service
@@ -39,12 +40,27 @@ func (l *LayoutService) Create(ctx context.Context, request CreateLayoutRequest)
 func (l *LayoutService) List(ctx context.Context, options *LayoutRequestOptions) (*LayoutsResponse, error) {
 	var resp LayoutsResponse
 	URL := l.client.config.BackendURL.JoinPath("layouts")
-	if options == nil {
-		options = &LayoutRequestOptions{}
+	if options != nil {
+		queryValues := URL.Query()
+		if options.Page != nil {
+			queryValues.Set("page", strconv.Itoa(*options.Page))
+		}
+		if options.PageSize != nil {
+			queryValues.Set("pageSize", strconv.Itoa(*options.PageSize))
+		}
+		if options.Key != nil {
+			queryValues.Set("key", *options.Key)
+		}
+		if options.SortBy != nil {
+			queryValues.Set("sortBy", *options.SortBy)
+		}
+		if options.OrderBy != nil {
+			queryValues.Set("orderBy", strconv.Itoa(*options.OrderBy))
+		}
+		URL.RawQuery = queryValues.Encode()
 	}
-	queryParams, _ := json.Marshal(options)
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, URL.String(), bytes.NewBuffer(queryParams))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, URL.String(), http.NoBody)
 	if err != nil {
 		return nil, err
 	}
@@ -131,3 +147,26 @@ func (l *LayoutService) SetDefault(ctx context.Context, key string) error {
 
 	return nil
 }
+
+// Preview renders the layout with data as the template variables and returns
+// the resulting email HTML.
+func (l *LayoutService) Preview(ctx context.Context, key string, data map[string]interface{}) (string, error) {
+	var resp struct {
+		Data string `json:"data"`
+	}
+	URL := l.client.config.BackendURL.JoinPath("layouts", key, "preview")
+
+	jsonBody, _ := json.Marshal(map[string]interface{}{"payload": data})
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, URL.String(), bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return "", err
+	}
+
+	_, err = l.client.sendRequest(req, &resp)
+	if err != nil {
+		return "", err
+	}
+
+	return resp.Data, nil
+}
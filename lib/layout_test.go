@@ -19,7 +19,7 @@ func TestLayoutService_Create_Layout_Success(t *testing.T) {
 		Identifier:  "layoutIdentifier",
 		Description: "layoutDescription",
 		Content:     "layoutContent",
-		Variables:   []interface{}(nil),
+		Variables:   []lib.LayoutVariable(nil),
 		IsDefault:   true,
 	}
 	res, _ := json.Marshal(createLayoutRequest)
@@ -65,7 +65,7 @@ func TestLayoutService_List_Layouts_Success(t *testing.T) {
 			Channel:        "in_app",
 			Content:        "layoutContent",
 			ContentType:    "layoutContentType",
-			Variables:      []interface{}{},
+			Variables:      []lib.LayoutVariable{},
 			IsDefault:      true,
 			IsDeleted:      false,
 			CreatedAt:      "createdAt",
@@ -89,6 +89,31 @@ func TestLayoutService_List_Layouts_Success(t *testing.T) {
 	require.Equal(t, expectedResponse, resp)
 }
 
+func TestLayoutService_List_Layouts_WithOptions_Success(t *testing.T) {
+	page := 1
+	pageSize := 10
+	expectedResponse := &lib.LayoutsResponse{
+		Page:       page,
+		PageSize:   pageSize,
+		TotalCount: 0,
+		Data:       []lib.LayoutResponse{},
+	}
+	httpServer := createTestServer(t, TestServerOptions[map[string]string, lib.LayoutsResponse]{
+		expectedURLPath:    "/v1/layouts?page=1&pageSize=10",
+		expectedSentMethod: http.MethodGet,
+		expectedSentBody:   map[string]string{},
+		responseStatusCode: http.StatusOK,
+		responseBody:       *expectedResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.LayoutApi.List(ctx, &lib.LayoutRequestOptions{Page: &page, PageSize: &pageSize})
+
+	require.NoError(t, err)
+	require.Equal(t, expectedResponse, resp)
+}
+
 func TestLayoutService_Get_Layout_Success(t *testing.T) {
 
 	var expectedResponse *lib.LayoutResponse = &lib.LayoutResponse{
@@ -102,7 +127,7 @@ func TestLayoutService_Get_Layout_Success(t *testing.T) {
 		Channel:        "in_app",
 		Content:        "layoutContent",
 		ContentType:    "layoutContentType",
-		Variables:      []interface{}{},
+		Variables:      []lib.LayoutVariable{},
 		IsDefault:      true,
 		IsDeleted:      false,
 		CreatedAt:      "createdAt",
@@ -150,7 +175,7 @@ func TestLayoutService_Update_Layout_Success(t *testing.T) {
 		Identifier:  "layoutIdentifier",
 		Description: "layoutDescription",
 		Content:     "layoutContent",
-		Variables:   []interface{}(nil),
+		Variables:   []lib.LayoutVariable(nil),
 		IsDefault:   false,
 	}
 	res, _ := json.Marshal(updateLayoutRequest)
@@ -166,7 +191,7 @@ func TestLayoutService_Update_Layout_Success(t *testing.T) {
 		Channel:        "in_app",
 		Content:        "layoutContent",
 		ContentType:    "layoutContentType",
-		Variables:      []interface{}{},
+		Variables:      []lib.LayoutVariable{},
 		IsDefault:      true,
 		IsDeleted:      false,
 		CreatedAt:      "createdAt",
@@ -205,3 +230,22 @@ func TestLayoutService_Layout_SetDefault(t *testing.T) {
 
 	require.NoError(t, err)
 }
+
+func TestLayoutService_Preview(t *testing.T) {
+	const html = "<html><body><header>Acme</header><p>Hello John</p></body></html>"
+
+	httpServer := createTestServer(t, TestServerOptions[map[string]interface{}, map[string]string]{
+		expectedURLPath:    fmt.Sprintf("/v1/layouts/%s/preview", LayoutId),
+		expectedSentMethod: http.MethodPost,
+		expectedSentBody:   map[string]interface{}{"payload": map[string]interface{}{"name": "John"}},
+		responseStatusCode: http.StatusCreated,
+		responseBody:       map[string]string{"data": html},
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	rendered, err := c.LayoutApi.Preview(ctx, LayoutId, map[string]interface{}{"name": "John"})
+
+	require.NoError(t, err)
+	require.Equal(t, html, rendered)
+}
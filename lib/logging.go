@@ -0,0 +1,54 @@
+package lib
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// maxLoggedBodySize bounds how much of an error response body is logged.
+const maxLoggedBodySize = 512
+
+// logResponse records a finished request on the configured logger: failures
+// and non-2xx responses at error level, everything else at debug level.
+func (c APIClient) logResponse(req *http.Request, res *http.Response, body []byte, err error, duration time.Duration) {
+	logger := c.config.Logger
+	if logger == nil {
+		return
+	}
+
+	attrs := []any{
+		slog.String("method", req.Method),
+		slog.String("url", req.URL.String()),
+		slog.Duration("duration", duration),
+	}
+
+	switch {
+	case err != nil:
+		logger.ErrorContext(req.Context(), "novu request failed", append(attrs, slog.Any("error", err))...)
+	case res.StatusCode >= http.StatusMultipleChoices:
+		if len(body) > maxLoggedBodySize {
+			body = body[:maxLoggedBodySize]
+		}
+		logger.ErrorContext(req.Context(), "novu request unsuccessful",
+			append(attrs, slog.Int("status", res.StatusCode), slog.String("body", string(body)))...)
+	default:
+		logger.DebugContext(req.Context(), "novu request", append(attrs, slog.Int("status", res.StatusCode))...)
+	}
+}
+
+// retryLogHook reports every retry attempt made by the retryable client.
+func retryLogHook(logger *slog.Logger) retryablehttp.RequestLogHook {
+	return func(_ retryablehttp.Logger, req *http.Request, attempt int) {
+		if attempt == 0 {
+			return
+		}
+		logger.WarnContext(req.Context(), "retrying novu request",
+			slog.String("method", req.Method),
+			slog.String("url", req.URL.String()),
+			slog.Int("attempt", attempt),
+		)
+	}
+}
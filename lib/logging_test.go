@@ -0,0 +1,60 @@
+package lib_test
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/novuhq/go-novu/lib"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogger_LogsRequests(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"data":{"acknowledged":true,"status":"processed"}}`))
+	}))
+	defer server.Close()
+
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(server.URL), Logger: logger})
+	_, err := c.EventApi.Trigger(context.Background(), "event", lib.ITriggerPayloadOptions{To: "subscriberId"})
+
+	require.NoError(t, err)
+	assert.Contains(t, buf.String(), "level=DEBUG")
+	assert.Contains(t, buf.String(), "method=POST")
+	assert.Contains(t, buf.String(), "status=201")
+}
+
+func TestLogger_LogsRetriesAndTruncatedErrors(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(strings.Repeat("x", 600) + "tail"))
+	}))
+	defer server.Close()
+
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{
+		BackendURL:  lib.MustParseURL(server.URL),
+		Logger:      logger,
+		RetryConfig: &lib.RetryConfigType{RetryMax: 1, WaitMin: time.Millisecond, WaitMax: time.Millisecond},
+	})
+	_, err := c.EventApi.Trigger(context.Background(), "event", lib.ITriggerPayloadOptions{To: "subscriberId"})
+
+	require.Error(t, err)
+	assert.Contains(t, buf.String(), "level=WARN")
+	assert.Contains(t, buf.String(), "attempt=1")
+	assert.Contains(t, buf.String(), "level=ERROR")
+	assert.Contains(t, buf.String(), "status=500")
+	assert.NotContains(t, buf.String(), "tail")
+}
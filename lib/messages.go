@@ -9,19 +9,19 @@ import (
 
 type MessagesService service
 
-func (e *MessagesService) GetMessages(ctx context.Context, q QueryBuilder) (JsonResponse, error) {
-	var resp JsonResponse
+func (e *MessagesService) GetMessages(ctx context.Context, q QueryBuilder) (*MessageListResponse, error) {
+	var resp MessageListResponse
 	URL := e.client.config.BackendURL.JoinPath("messages")
 	URL.RawQuery = q.BuildQuery()
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, URL.String(), http.NoBody)
 	if err != nil {
-		return resp, err
+		return nil, err
 	}
 	_, err = e.client.sendRequest(req, &resp)
 	if err != nil {
-		return resp, err
+		return nil, err
 	}
-	return resp, nil
+	return &resp, nil
 }
 
 func (e *MessagesService) DeleteMessage(ctx context.Context, messageId string) (JsonResponse, error) {
@@ -38,10 +38,22 @@ func (e *MessagesService) DeleteMessage(ctx context.Context, messageId string) (
 	return resp, nil
 }
 
+func (e *MessagesService) MarkMessageAsRead(ctx context.Context, subscriberId string, messageId string) (*SubscriberNotificationFeedResponse, error) {
+	return (*SubscriberService)(e).MarkMessageSeen(ctx, subscriberId, SubscriberMarkMessageSeenOptions{
+		MessageID: messageId,
+		Seen:      true,
+		Read:      true,
+	})
+}
+
+func (e *MessagesService) MarkAllMessagesAsRead(ctx context.Context, subscriberId string, feedIdentifier string) (*MarkAllMessagesResponse, error) {
+	return (*SubscriberService)(e).MarkAllMessagesRead(ctx, subscriberId, feedIdentifier, true)
+}
+
 func (q MessagesQueryParams) BuildQuery() string {
 	params := url.Values{}
 	if q.Channel != "" {
-		params.Add("channel", q.Channel)
+		params.Add("channel", string(q.Channel))
 	}
 	if q.SubscriberId != "" {
 		params.Add("subscriberId", q.SubscriberId)
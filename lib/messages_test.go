@@ -2,6 +2,7 @@ package lib_test
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -121,9 +122,12 @@ func TestGetMessages(t *testing.T) {
 	if err != nil {
 		t.Errorf("Error should be nil, got %v", err)
 	}
-	if resp.Data == nil || resp.Data == "" {
+	if len(resp.Data) == 0 {
 		t.Error("Expected response, got none")
 	}
+	if resp.Data[0].TransactionId != "string" {
+		t.Errorf("Want transactionId string, got %s", resp.Data[0].TransactionId)
+	}
 }
 
 func TestMessagesBuildQuery(t *testing.T) {
@@ -183,3 +187,54 @@ func TestDeleteMessage(t *testing.T) {
 		t.Error("Expected response, got none")
 	}
 }
+
+func TestMarkMessageAsRead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Want POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/v1/subscribers/SubscriberId/messages/markAs" {
+			t.Errorf("Want /v1/subscribers/SubscriberId/messages/markAs, got %s", r.URL.Path)
+		}
+		var body lib.SubscriberMarkMessageSeenOptions
+		json.NewDecoder(r.Body).Decode(&body)
+		if body.MessageID != "MessageId" || !body.Read || !body.Seen {
+			t.Errorf("Want message MessageId marked as read, got %+v", body)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":[]}`))
+	}))
+	defer server.Close()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(server.URL)})
+	_, err := c.MessagesApi.MarkMessageAsRead(context.Background(), "SubscriberId", "MessageId")
+	if err != nil {
+		t.Errorf("Error should be nil, got %v", err)
+	}
+}
+
+func TestMarkAllMessagesAsRead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Want POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/v1/subscribers/SubscriberId/messages/mark-all" {
+			t.Errorf("Want /v1/subscribers/SubscriberId/messages/mark-all, got %s", r.URL.Path)
+		}
+		var body lib.MarkAllMessagesRequest
+		json.NewDecoder(r.Body).Decode(&body)
+		if body.MarkAs != lib.MessageMarkAsRead || body.FeedIdentifier != "FeedId" {
+			t.Errorf("Want feed FeedId marked as read, got %+v", body)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":3}`))
+	}))
+	defer server.Close()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(server.URL)})
+	resp, err := c.MessagesApi.MarkAllMessagesAsRead(context.Background(), "SubscriberId", "FeedId")
+	if err != nil {
+		t.Errorf("Error should be nil, got %v", err)
+	}
+	if resp.Data != 3 {
+		t.Errorf("Want 3 messages updated, got %d", resp.Data)
+	}
+}
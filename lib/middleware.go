@@ -0,0 +1,68 @@
+package lib
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Middleware intercepts every request sent by the client. It must call
+// next.RoundTrip to continue the chain, and may inspect or modify the request
+// before and the response after.
+type Middleware func(req *http.Request, next http.RoundTripper) (*http.Response, error)
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// roundTrip sends the request through the configured middlewares, the first
+// one being the outermost.
+func (c APIClient) roundTrip(req *http.Request) (*http.Response, error) {
+	var next http.RoundTripper = roundTripperFunc(c.config.HttpClient.Do)
+
+	for i := len(c.config.Middlewares) - 1; i >= 0; i-- {
+		mw, inner := c.config.Middlewares[i], next
+		next = roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			return mw(req, inner)
+		})
+	}
+
+	return next.RoundTrip(req)
+}
+
+// LoggingMiddleware logs the method, URL, status and duration of every
+// request at debug level, and failed requests at error level.
+func LoggingMiddleware(logger *slog.Logger) Middleware {
+	return func(req *http.Request, next http.RoundTripper) (*http.Response, error) {
+		start := time.Now()
+		res, err := next.RoundTrip(req)
+
+		attrs := []any{
+			slog.String("method", req.Method),
+			slog.String("url", req.URL.String()),
+			slog.Duration("duration", time.Since(start)),
+		}
+		if err != nil {
+			logger.ErrorContext(req.Context(), "novu request failed", append(attrs, slog.Any("error", err))...)
+			return res, err
+		}
+
+		logger.DebugContext(req.Context(), "novu request", append(attrs, slog.Int("status", res.StatusCode))...)
+		return res, nil
+	}
+}
+
+// RequestIDMiddleware sets a random X-Request-ID header on requests that do
+// not already carry one.
+func RequestIDMiddleware() Middleware {
+	return func(req *http.Request, next http.RoundTripper) (*http.Response, error) {
+		if req.Header.Get("X-Request-ID") == "" {
+			req.Header.Set("X-Request-ID", uuid.New().String())
+		}
+		return next.RoundTrip(req)
+	}
+}
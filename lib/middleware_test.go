@@ -0,0 +1,85 @@
+package lib_test
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/novuhq/go-novu/lib"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newMiddlewareTestServer(t *testing.T, onRequest func(req *http.Request)) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		onRequest(req)
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"data":{"acknowledged":true,"status":"processed"}}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestMiddleware_AppliedInOrder(t *testing.T) {
+	var calls []string
+	var receivedHeader string
+	server := newMiddlewareTestServer(t, func(req *http.Request) {
+		receivedHeader = req.Header.Get("X-Custom")
+	})
+
+	record := func(name string) lib.Middleware {
+		return func(req *http.Request, next http.RoundTripper) (*http.Response, error) {
+			calls = append(calls, name+":before")
+			req.Header.Set("X-Custom", req.Header.Get("X-Custom")+name)
+			res, err := next.RoundTrip(req)
+			calls = append(calls, name+":after")
+			return res, err
+		}
+	}
+
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{
+		BackendURL:  lib.MustParseURL(server.URL),
+		Middlewares: []lib.Middleware{record("a"), record("b")},
+	})
+	_, err := c.EventApi.Trigger(context.Background(), "event", lib.ITriggerPayloadOptions{To: "subscriberId"})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a:before", "b:before", "b:after", "a:after"}, calls)
+	assert.Equal(t, "ab", receivedHeader)
+}
+
+func TestRequestIDMiddleware(t *testing.T) {
+	var requestID string
+	server := newMiddlewareTestServer(t, func(req *http.Request) {
+		requestID = req.Header.Get("X-Request-ID")
+	})
+
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{
+		BackendURL:  lib.MustParseURL(server.URL),
+		Middlewares: []lib.Middleware{lib.RequestIDMiddleware()},
+	})
+	_, err := c.EventApi.Trigger(context.Background(), "event", lib.ITriggerPayloadOptions{To: "subscriberId"})
+
+	require.NoError(t, err)
+	assert.NotEmpty(t, requestID)
+}
+
+func TestLoggingMiddleware(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	server := newMiddlewareTestServer(t, func(req *http.Request) {})
+
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{
+		BackendURL:  lib.MustParseURL(server.URL),
+		Middlewares: []lib.Middleware{lib.LoggingMiddleware(logger)},
+	})
+	_, err := c.EventApi.Trigger(context.Background(), "event", lib.ITriggerPayloadOptions{To: "subscriberId"})
+
+	require.NoError(t, err)
+	assert.Contains(t, buf.String(), "method=POST")
+	assert.Contains(t, buf.String(), "status=201")
+}
@@ -18,22 +18,64 @@ const (
 	HTTPRedirectOk     = 300
 )
 
+// Attachment channels, see IAttachmentOptions.
 const (
 	EMAIL  ChannelType = "EMAIL"
 	SMS    ChannelType = "SMS"
 	DIRECT ChannelType = "DIRECT"
 )
 
+// Delivery channels of messages, integrations and layouts.
 const (
-	slack       ProviderIdType = "slack"
-	discord     ProviderIdType = "discord"
-	msteams     ProviderIdType = "msteams"
-	mattermost  ProviderIdType = "mattermost"
-	fcm         ProviderIdType = "fcm"
-	apns        ProviderIdType = "apns"
-	expo        ProviderIdType = "expo"
-	oneSignal   ProviderIdType = "one-signal"
-	pushWebhook ProviderIdType = "push-webhook"
+	ChannelTypeEmail ChannelType = "email"
+	ChannelTypeSMS   ChannelType = "sms"
+	ChannelTypePush  ChannelType = "push"
+	ChannelTypeInApp ChannelType = "in_app"
+	ChannelTypeChat  ChannelType = "chat"
+)
+
+// Provider ids accepted when creating integrations and subscriber credentials.
+const (
+	// Email
+	ProviderIDSendgrid    ProviderIdType = "sendgrid"
+	ProviderIDMailgun     ProviderIdType = "mailgun"
+	ProviderIDMailjet     ProviderIdType = "mailjet"
+	ProviderIDMandrill    ProviderIdType = "mandrill"
+	ProviderIDPostmark    ProviderIdType = "postmark"
+	ProviderIDSES         ProviderIdType = "ses"
+	ProviderIDSendinblue  ProviderIdType = "sendinblue"
+	ProviderIDMailerSend  ProviderIdType = "mailersend"
+	ProviderIDResend      ProviderIdType = "resend"
+	ProviderIDSparkPost   ProviderIdType = "sparkpost"
+	ProviderIDOutlook365  ProviderIdType = "outlook365"
+	ProviderIDSMTP        ProviderIdType = "nodemailer"
+	ProviderIDInfobipMail ProviderIdType = "infobip-email"
+
+	// SMS
+	ProviderIDTwilio      ProviderIdType = "twilio"
+	ProviderIDNexmo       ProviderIdType = "nexmo"
+	ProviderIDPlivo       ProviderIdType = "plivo"
+	ProviderIDSNS         ProviderIdType = "sns"
+	ProviderIDTelnyx      ProviderIdType = "telnyx"
+	ProviderIDTermii      ProviderIdType = "termii"
+	ProviderIDMessageBird ProviderIdType = "messagebird"
+	ProviderIDInfobipSMS  ProviderIdType = "infobip-sms"
+
+	// Push
+	ProviderIDFCM         ProviderIdType = "fcm"
+	ProviderIDAPNS        ProviderIdType = "apns"
+	ProviderIDExpo        ProviderIdType = "expo"
+	ProviderIDOneSignal   ProviderIdType = "one-signal"
+	ProviderIDPushWebhook ProviderIdType = "push-webhook"
+
+	// Chat
+	ProviderIDSlack      ProviderIdType = "slack"
+	ProviderIDDiscord    ProviderIdType = "discord"
+	ProviderIDMSTeams    ProviderIdType = "msteams"
+	ProviderIDMattermost ProviderIdType = "mattermost"
+
+	// In-app
+	ProviderIDNovu ProviderIdType = "novu"
 )
 
 type Data struct {
@@ -50,9 +92,20 @@ type ITriggerPayloadOptions struct {
 	Payload       interface{} `json:"payload,omitempty"`
 	Overrides     interface{} `json:"overrides,omitempty"`
 	TransactionId string      `json:"transactionId,omitempty"`
-	Actor         interface{} `json:"actor,omitempty"`
+	// Actor is who caused the notification, either a subscriber id or an
+	// ActorPayload, available to templates as {{actor}}.
+	Actor interface{} `json:"actor,omitempty"`
+	// IdempotencyKey is sent as the Idempotency-Key header instead of the
+	// generated one, so retries from the caller are deduplicated by the API.
+	IdempotencyKey string `json:"-"`
 }
 
+// ProviderOverrides configures the providers for a single trigger. Keys are
+// channels such as "email" or "sms", e.g. {"email": {"integrationIdentifier":
+// "sendgrid-eu"}}, or provider ids whose settings are overridden, e.g.
+// {"sendgrid": {"templateId": "d-123"}}.
+type ProviderOverrides map[string]map[string]interface{}
+
 type TriggerRecipientsTypeArray interface {
 	[]string | []SubscriberPayload
 }
@@ -76,6 +129,34 @@ type SubscriberPayload struct {
 	SubscriberId string                 `json:"subscriberId"`
 }
 
+// ActorPayload identifies the subscriber who performed the action behind a
+// notification, created or updated like the recipients of the trigger.
+type ActorPayload struct {
+	SubscriberId string                 `json:"subscriberId"`
+	FirstName    string                 `json:"firstName,omitempty"`
+	LastName     string                 `json:"lastName,omitempty"`
+	Email        string                 `json:"email,omitempty"`
+	Avatar       string                 `json:"avatar,omitempty"`
+	Data         map[string]interface{} `json:"data,omitempty"`
+}
+
+// MaxBulkDeleteSubscribers is the most subscribers SubscriberService.BulkDelete
+// accepts in one call.
+const MaxBulkDeleteSubscribers = 100
+
+const bulkDeleteConcurrency = 5
+
+type BulkDeleteError struct {
+	SubscriberID string
+	Error        string
+}
+
+type BulkDeleteResult struct {
+	Deleted  []string
+	NotFound []string
+	Errors   []BulkDeleteError
+}
+
 type SubscriberBulkPayload struct {
 	Subscribers []SubscriberPayload `json:"subscribers"`
 }
@@ -102,8 +183,52 @@ type ExecutionsQueryParams struct {
 	SubscriberId   string
 }
 
+type ExecutionDetail struct {
+	Id                     string      `json:"_id"`
+	OrganizationId         string      `json:"_organizationId"`
+	JobId                  string      `json:"_jobId"`
+	EnvironmentId          string      `json:"_environmentId"`
+	NotificationId         string      `json:"_notificationId"`
+	NotificationTemplateId string      `json:"_notificationTemplateId"`
+	SubscriberId           string      `json:"_subscriberId"`
+	MessageId              string      `json:"_messageId,omitempty"`
+	ProviderId             string      `json:"providerId,omitempty"`
+	TransactionId          string      `json:"transactionId"`
+	Channel                ChannelType `json:"channel"`
+	Detail                 string      `json:"detail"`
+	Source                 string      `json:"source"`
+	Status                 string      `json:"status"`
+	IsTest                 bool        `json:"isTest"`
+	IsRetry                bool        `json:"isRetry"`
+	Raw                    string      `json:"raw,omitempty"`
+	CreatedAt              string      `json:"createdAt"`
+}
+
+type ExecutionDetailsResponse struct {
+	Data []ExecutionDetail `json:"data"`
+}
+
+type EventResponseData struct {
+	Acknowledged  bool     `json:"acknowledged"`
+	Status        string   `json:"status"`
+	TransactionId string   `json:"transactionId,omitempty"`
+	Error         []string `json:"error,omitempty"`
+}
+
+// TriggerCheckResult is what EventService.CheckTrigger found out locally about
+// a trigger. It is not an answer of the API: a trigger that passes the check
+// can still be rejected by Trigger.
+type TriggerCheckResult struct {
+	WorkflowId       string   // Id of the workflow with the trigger identifier
+	Active           bool     // Whether the workflow is active; Trigger skips inactive ones
+	MissingVariables []string // Workflow variables not set in the payload
+}
+
 type EventResponse struct {
-	JsonResponse
+	Data EventResponseData `json:"data"`
+	// IsIdempotentReplay reports whether the API answered with the result of an
+	// earlier request that used the same idempotency key.
+	IsIdempotentReplay bool `json:"-"`
 }
 
 type EventRequest struct {
@@ -116,13 +241,146 @@ type EventRequest struct {
 }
 
 type MessagesQueryParams struct {
-	Channel       string
+	Channel       ChannelType
 	SubscriberId  string
 	TransactionId []string
 	Page          int
 	Limit         int
 }
 
+type Message struct {
+	Id                 string                 `json:"_id"`
+	TemplateId         string                 `json:"_templateId"`
+	EnvironmentId      string                 `json:"_environmentId"`
+	OrganizationId     string                 `json:"_organizationId"`
+	NotificationId     string                 `json:"_notificationId"`
+	SubscriberId       string                 `json:"_subscriberId"`
+	FeedId             string                 `json:"_feedId,omitempty"`
+	TemplateIdentifier string                 `json:"templateIdentifier"`
+	TransactionId      string                 `json:"transactionId"`
+	Channel            ChannelType            `json:"channel"`
+	Subject            string                 `json:"subject,omitempty"`
+	Content            interface{}            `json:"content"`
+	Payload            map[string]interface{} `json:"payload,omitempty"`
+	ProviderId         string                 `json:"providerId,omitempty"`
+	Status             string                 `json:"status"`
+	ErrorText          string                 `json:"errorText,omitempty"`
+	Seen               bool                   `json:"seen"`
+	Read               bool                   `json:"read"`
+	LastSeenDate       string                 `json:"lastSeenDate,omitempty"`
+	LastReadDate       string                 `json:"lastReadDate,omitempty"`
+	CreatedAt          string                 `json:"createdAt"`
+	UpdatedAt          string                 `json:"updatedAt"`
+}
+
+type MessageListResponse struct {
+	HasMore    bool      `json:"hasMore"`
+	TotalCount int       `json:"totalCount"`
+	PageSize   int       `json:"pageSize"`
+	Page       int       `json:"page"`
+	Data       []Message `json:"data"`
+}
+
+type GetNotificationsOptions struct {
+	Channels       []StepType
+	Templates      []string // Workflow ids
+	Emails         []string
+	SubscriberIds  []string
+	TransactionIds []string
+	Search         string
+	After          time.Time // Only notifications created after, ignored when zero
+	Before         time.Time // Only notifications created before, ignored when zero
+	Page           int
+	Limit          int
+}
+
+type NotificationSubscriber struct {
+	Id           string `json:"_id"`
+	SubscriberId string `json:"subscriberId"`
+	FirstName    string `json:"firstName,omitempty"`
+	LastName     string `json:"lastName,omitempty"`
+	Email        string `json:"email,omitempty"`
+	Phone        string `json:"phone,omitempty"`
+}
+
+type NotificationTemplate struct {
+	Id       string            `json:"_id"`
+	Name     string            `json:"name"`
+	Triggers []WorkflowTrigger `json:"triggers"`
+}
+
+type NotificationJob struct {
+	Id         string                 `json:"_id"`
+	Type       StepType               `json:"type"`
+	Status     string                 `json:"status"`
+	ProviderId string                 `json:"providerId,omitempty"`
+	Digest     map[string]interface{} `json:"digest,omitempty"`
+	Payload    map[string]interface{} `json:"payload,omitempty"`
+	CreatedAt  string                 `json:"createdAt"`
+	UpdatedAt  string                 `json:"updatedAt"`
+}
+
+type NotificationItem struct {
+	Id             string                  `json:"_id"`
+	EnvironmentId  string                  `json:"_environmentId"`
+	OrganizationId string                  `json:"_organizationId"`
+	TransactionId  string                  `json:"transactionId"`
+	Channels       []StepType              `json:"channels"`
+	Template       *NotificationTemplate   `json:"template,omitempty"`
+	Subscriber     *NotificationSubscriber `json:"subscriber,omitempty"`
+	Payload        map[string]interface{}  `json:"payload,omitempty"`
+	Jobs           []NotificationJob       `json:"jobs,omitempty"` // One per step, carrying its delivery status
+	CreatedAt      string                  `json:"createdAt"`
+}
+
+type NotificationListResponse struct {
+	HasMore  bool               `json:"hasMore"`
+	PageSize int                `json:"pageSize"`
+	Page     int                `json:"page"`
+	Data     []NotificationItem `json:"data"`
+}
+
+type NotificationStats struct {
+	WeeklySent  int `json:"weeklySent"`
+	MonthlySent int `json:"monthlySent"`
+}
+
+type NotificationStatsResponse struct {
+	Data NotificationStats `json:"data"`
+}
+
+type NotificationGraphPoint struct {
+	Day   time.Time
+	Count int
+}
+
+type NotificationGraphResponse struct {
+	Data []NotificationGraphPoint `json:"data"`
+}
+
+type MessageMarkAs string
+
+const (
+	MessageMarkAsRead   MessageMarkAs = "read"
+	MessageMarkAsSeen   MessageMarkAs = "seen"
+	MessageMarkAsUnread MessageMarkAs = "unread"
+	MessageMarkAsUnseen MessageMarkAs = "unseen"
+)
+
+type MarkAllMessagesRequest struct {
+	MarkAs         MessageMarkAs `json:"markAs"`
+	FeedIdentifier string        `json:"feedIdentifier,omitempty"`
+}
+
+type MarkAllMessagesResponse struct {
+	Data int `json:"data"`
+}
+
+type MarkMessagesAsRequest struct {
+	MessageIds []string      `json:"messageId"`
+	MarkAs     MessageMarkAs `json:"markAs"`
+}
+
 // QueryBuilder gives us an interface to pass as arg to our API methods.
 // See messages.go for an example of implementing this interface
 type QueryBuilder interface {
@@ -133,6 +391,46 @@ type SubscriberResponse struct {
 	JsonResponse
 }
 
+type UpdateSubscriberOnlineStatusRequest struct {
+	IsOnline     bool   `json:"isOnline"`
+	LastOnlineAt string `json:"lastOnlineAt,omitempty"`
+}
+
+type Subscriber struct {
+	Id             string                 `json:"_id"`
+	SubscriberId   string                 `json:"subscriberId"`
+	FirstName      string                 `json:"firstName,omitempty"`
+	LastName       string                 `json:"lastName,omitempty"`
+	Email          string                 `json:"email,omitempty"`
+	Phone          string                 `json:"phone,omitempty"`
+	Avatar         string                 `json:"avatar,omitempty"`
+	Locale         string                 `json:"locale,omitempty"`
+	Data           map[string]interface{} `json:"data,omitempty"`
+	Channels       []interface{}          `json:"channels,omitempty"`
+	IsOnline       bool                   `json:"isOnline,omitempty"`
+	LastOnlineAt   string                 `json:"lastOnlineAt,omitempty"`
+	OrganizationId string                 `json:"_organizationId"`
+	EnvironmentId  string                 `json:"_environmentId"`
+	Deleted        bool                   `json:"deleted"`
+	CreatedAt      string                 `json:"createdAt"`
+	UpdatedAt      string                 `json:"updatedAt"`
+}
+
+type SubscriberListOptions struct {
+	Page  int `queryKey:"page"`
+	Limit int `queryKey:"limit"`
+	// Query is matched by the server against email, phone, name and subscriberId.
+	Query string `queryKey:"query"`
+}
+
+type SubscriberListResponse struct {
+	Page       int          `json:"page"`
+	PageSize   int          `json:"pageSize"`
+	TotalCount int          `json:"totalCount"`
+	HasMore    bool         `json:"hasMore"`
+	Data       []Subscriber `json:"data"`
+}
+
 type SubscriberBulkCreateResponse struct {
 	Data struct {
 		Updated []struct {
@@ -164,11 +462,17 @@ type Channel struct {
 	Push  bool `json:"push"`
 }
 
+type SubscriberPreference struct {
+	Template   Template   `json:"template"`
+	Preference Preference `json:"preference"`
+}
+
 type SubscriberPreferencesResponse struct {
-	Data []struct {
-		Template   Template   `json:"template"`
-		Preference Preference `json:"preference"`
-	} `json:"data"`
+	Data []SubscriberPreference `json:"data"`
+}
+
+type SubscriberPreferenceResponse struct {
+	Data SubscriberPreference `json:"data"`
 }
 
 type UpdateSubscriberPreferencesChannel struct {
@@ -181,8 +485,24 @@ type UpdateSubscriberPreferencesOptions struct {
 	Enabled bool                                 `json:"enabled,omitempty"`
 }
 
+type UpdateSubscriberGlobalPreferencesOptions struct {
+	Enabled     *bool                                `json:"enabled,omitempty"`
+	Preferences []UpdateSubscriberPreferencesChannel `json:"preferences,omitempty"`
+}
+
+// UpdatePreferenceRequest is one preference update of
+// SubscriberService.UpdateAllSubscriberPreferences. An empty TemplateId updates
+// the subscriber's global preferences.
+type UpdatePreferenceRequest struct {
+	TemplateId string                               `json:"-"`
+	Enabled    *bool                                `json:"enabled,omitempty"`
+	Channels   []UpdateSubscriberPreferencesChannel `json:"channel,omitempty"`
+}
+
+const preferenceUpdateConcurrency = 5
+
 type ListTopicsResponse struct {
-	Page       int                `json:"name"`
+	Page       int                `json:"page"`
 	PageSize   int                `json:"pageSize"`
 	TotalCount int                `json:"totalCount"`
 	Data       []GetTopicResponse `json:"data"`
@@ -212,6 +532,19 @@ type ListTopicsOptions struct {
 	Key      *string `json:"key,omitempty"`
 }
 
+type TopicSubscribersFailed struct {
+	NotFound []string `json:"notFound,omitempty"`
+}
+
+type TopicSubscribersResult struct {
+	Succeeded []string               `json:"succeeded"`
+	Failed    TopicSubscribersFailed `json:"failed"`
+}
+
+type TopicSubscribersResponse struct {
+	Data TopicSubscribersResult `json:"data"`
+}
+
 type CreateTopicRequest struct {
 	Name string `json:"name"`
 	Key  string `json:"key"`
@@ -225,10 +558,33 @@ type SubscribersTopicRequest struct {
 	Subscribers []string `json:"subscribers"`
 }
 
+// MaxTopicSubscribersBatchSize is the number of subscribers the API accepts in
+// a single call adding subscribers to a topic.
+const MaxTopicSubscribersBatchSize = 100
+
+const defaultAddSubscribersConcurrency = 5
+
+type AddSubscriberOptions struct {
+	Concurrency int // Batches sent at the same time, defaults to 5
+}
+
+type BulkAddError struct {
+	SubscriberID string
+	Error        string
+}
+
+type BulkAddResult struct {
+	Added       []string
+	FailedToAdd []BulkAddError
+	TotalAdded  int
+}
+
 type SubscriberNotificationFeedOptions struct {
 	Page           int         `queryKey:"page"`
+	Limit          int         `queryKey:"limit"`
 	FeedIdentifier string      `queryKey:"feedIdentifier"`
-	Seen           bool        `queryKey:"seen"`
+	Seen           *bool       `queryKey:"seen"`
+	Read           *bool       `queryKey:"read"`
 	Payload        interface{} `queryKey:"payload"`
 }
 type Base64Payload struct {
@@ -236,7 +592,13 @@ type Base64Payload struct {
 }
 
 type SubscriberUnseenCountOptions struct {
-	Seen *bool `json:"seen"`
+	Seen           *bool  `json:"seen"`
+	FeedIdentifier string `json:"feedIdentifier"`
+}
+
+type SubscriberUnreadCountOptions struct {
+	Read           *bool  `json:"read"`
+	FeedIdentifier string `json:"feedIdentifier"`
 }
 
 type SubscriberMarkMessageSeenOptions struct {
@@ -246,24 +608,24 @@ type SubscriberMarkMessageSeenOptions struct {
 }
 
 type NotificationFeedData struct {
-	CTA              CTA       `json:"cta"`
-	Channel          string    `json:"channel"`
-	Content          string    `json:"content"`
-	CreatedAt        time.Time `json:"createdAt"`
-	Deleted          bool      `json:"deleted"`
-	DeviceTokens     []string  `json:"deviceTokens"`
-	DirectWebhookURL string    `json:"directWebhookUrl"`
-	EnvironmentID    string    `json:"_environmentId"`
-	ErrorID          string    `json:"errorId"`
-	ErrorText        string    `json:"errorText"`
-	FeedID           string    `json:"_feedId"`
-	ID               string    `json:"_id"`
-	JobID            string    `json:"_jobId"`
-	LastReadDate     time.Time `json:"lastReadDate"`
-	LastSeenDate     time.Time `json:"lastSeenDate"`
-	MessageTemplate  string    `json:"_messageTemplateId"`
-	NotificationID   string    `json:"_notificationId"`
-	OrganizationID   string    `json:"_organizationId"`
+	CTA              CTA         `json:"cta"`
+	Channel          ChannelType `json:"channel"`
+	Content          string      `json:"content"`
+	CreatedAt        time.Time   `json:"createdAt"`
+	Deleted          bool        `json:"deleted"`
+	DeviceTokens     []string    `json:"deviceTokens"`
+	DirectWebhookURL string      `json:"directWebhookUrl"`
+	EnvironmentID    string      `json:"_environmentId"`
+	ErrorID          string      `json:"errorId"`
+	ErrorText        string      `json:"errorText"`
+	FeedID           string      `json:"_feedId"`
+	ID               string      `json:"_id"`
+	JobID            string      `json:"_jobId"`
+	LastReadDate     time.Time   `json:"lastReadDate"`
+	LastSeenDate     time.Time   `json:"lastSeenDate"`
+	MessageTemplate  string      `json:"_messageTemplateId"`
+	NotificationID   string      `json:"_notificationId"`
+	OrganizationID   string      `json:"_organizationId"`
 	Payload          struct {
 		UpdateMessage string `json:"updateMessage"`
 	} `json:"payload"`
@@ -285,6 +647,7 @@ type NotificationFeedData struct {
 
 type SubscriberNotificationFeedResponse struct {
 	TotalCount int                    `json:"totalCount"`
+	HasMore    bool                   `json:"hasMore"`
 	Data       []NotificationFeedData `json:"data"`
 	PageSize   int                    `json:"pageSize"`
 	Page       int                    `json:"page"`
@@ -296,6 +659,12 @@ type SubscriberUnseenCountResponse struct {
 	} `json:"data"`
 }
 
+type SubscriberUnreadCountResponse struct {
+	Data struct {
+		Count int `json:"count"`
+	} `json:"data"`
+}
+
 type Credentials struct {
 	WebhookUrl   string   `json:"webhookUrl,omitempty"`
 	Channel      string   `json:"channel,omitempty"`
@@ -308,6 +677,24 @@ type SubscriberCredentialPayload struct {
 	ProviderId            ProviderIdType `json:"providerId"`
 }
 
+// MergeSubscribersResult lists the steps of SubscriberService.MergeSubscribers
+// that were applied to the primary subscriber. It is returned with an error too.
+type MergeSubscribersResult struct {
+	ProfileMerged     bool             // Empty profile fields or data keys were filled in
+	CredentialsMerged []ProviderIdType // Providers whose credentials were copied
+	TopicsJoined      []string         // Keys of the topics the primary joined
+	Completed         bool             // Every step succeeded, so the secondary can be deleted
+	Subscriber        SubscriberResponse
+}
+
+// SubscriberChannelCredential is a provider credential stored on a subscriber,
+// such as push device tokens or a chat webhook URL.
+type SubscriberChannelCredential struct {
+	ProviderId            ProviderIdType         `json:"providerId"`
+	Credentials           map[string]interface{} `json:"credentials"`
+	IntegrationIdentifier string                 `json:"integrationIdentifier,omitempty"`
+}
+
 type CTA struct {
 	Type   string `json:"type"`
 	Action struct {
@@ -347,11 +734,12 @@ type IntegrationCredentials struct {
 }
 
 type CreateIntegrationRequest struct {
-	ProviderID  string                 `json:"providerId"`
-	Channel     ChannelType            `json:"channel"`
-	Credentials IntegrationCredentials `json:"credentials,omitempty"`
-	Active      bool                   `json:"active"`
-	Check       bool                   `json:"check"`
+	ProviderID    ProviderIdType         `json:"providerId"`
+	Channel       ChannelType            `json:"channel"`
+	Credentials   IntegrationCredentials `json:"credentials,omitempty"`
+	Active        bool                   `json:"active"`
+	Check         bool                   `json:"check"`
+	EnvironmentId string                 `json:"_environmentId,omitempty"`
 }
 
 type UpdateIntegrationRequest struct {
@@ -360,14 +748,22 @@ type UpdateIntegrationRequest struct {
 	Check       bool                   `json:"check"`
 }
 
+type UpdateIntegrationCredentialsRequest struct {
+	Credentials IntegrationCredentials `json:"credentials"`
+	Check       bool                   `json:"check"`
+}
+
 type Integration struct {
 	Id             string                 `json:"_id"`
 	EnvironmentID  string                 `json:"_environmentId"`
 	OrganizationID string                 `json:"_organizationId"`
-	ProviderID     string                 `json:"providerId"`
+	Name           string                 `json:"name,omitempty"`
+	Identifier     string                 `json:"identifier,omitempty"`
+	ProviderID     ProviderIdType         `json:"providerId"`
 	Channel        ChannelType            `json:"channel"`
 	Credentials    IntegrationCredentials `json:"credentials"`
 	Active         bool                   `json:"active"`
+	Primary        bool                   `json:"primary,omitempty"`
 	Deleted        bool                   `json:"deleted"`
 	UpdatedAt      string                 `json:"updatedAt"`
 	DeletedAt      string                 `json:"deletedAt"`
@@ -396,8 +792,8 @@ type SetIntegrationAsPrimaryResponse struct {
 		OrganizationID string                 `json:"_organizationId"`
 		Name           string                 `json:"name"`
 		Identifier     string                 `json:"identifier"`
-		ProviderID     string                 `json:"providerId"`
-		Channel        string                 `json:"channel"`
+		ProviderID     ProviderIdType         `json:"providerId"`
+		Channel        ChannelType            `json:"channel"`
 		Credentials    IntegrationCredentials `json:"credentials"`
 		Active         bool                   `json:"active"`
 		Deleted        bool                   `json:"deleted"`
@@ -415,6 +811,10 @@ type BulkTriggerOptions struct {
 	Actor         interface{} `json:"actor,omitempty"`
 }
 
+// MaxBulkTriggerEvents is the most events EventService.TriggerBulk accepts in
+// one call.
+const MaxBulkTriggerEvents = 100
+
 type BulkTriggerEvent struct {
 	Events []BulkTriggerOptions `json:"events"`
 }
@@ -426,6 +826,22 @@ type BroadcastEventToAll struct {
 	TransactionId string      `json:"transactionId,omitempty"`
 	Actor         interface{} `json:"actor,omitempty"`
 }
+type Feed struct {
+	Id             string `json:"_id"`
+	Name           string `json:"name"`
+	Identifier     string `json:"identifier"`
+	EnvironmentId  string `json:"_environmentId"`
+	OrganizationId string `json:"_organizationId"`
+}
+
+type FeedResponse struct {
+	Data Feed `json:"data"`
+}
+
+type FeedsResponse struct {
+	Data []Feed `json:"data"`
+}
+
 type MxRecordConfiguredStatus struct {
 	MxRecordConfigured bool `json:"mxRecordConfigured"`
 }
@@ -433,13 +849,20 @@ type InboundParserResponse struct {
 	Data MxRecordConfiguredStatus `json:"data"`
 }
 
+type LayoutVariable struct {
+	Name         string      `json:"name"`
+	Type         string      `json:"type,omitempty"`
+	Required     bool        `json:"required,omitempty"`
+	DefaultValue interface{} `json:"defaultValue,omitempty"`
+}
+
 type CreateLayoutRequest struct {
-	Name        string        `json:"name"`
-	Identifier  string        `json:"identifier"`
-	Description string        `json:"description"`
-	Content     string        `json:"content"`
-	Variables   []interface{} `json:"variables,omitempty"`
-	IsDefault   bool          `json:"isDefault,omitempty"`
+	Name        string           `json:"name"`
+	Identifier  string           `json:"identifier"`
+	Description string           `json:"description"`
+	Content     string           `json:"content"`
+	Variables   []LayoutVariable `json:"variables,omitempty"`
+	IsDefault   bool             `json:"isDefault,omitempty"`
 }
 
 type CreateLayoutResponse struct {
@@ -451,25 +874,26 @@ type LayoutRequestOptions struct {
 	Page     *int    `json:"page,omitempty"`
 	PageSize *int    `json:"pageSize,omitempty"`
 	Key      *string `json:"key,omitempty"`
+	SortBy   *string `json:"sortBy,omitempty"`
 	OrderBy  *int    `json:"orderBy,omitempty"`
 }
 type LayoutResponse struct {
-	Id             string        `json:"_id"`
-	OrganizationId string        `json:"_organizationId"`
-	EnvironmentId  string        `json:"_environmentId"`
-	CreatorId      string        `json:"_creatorId"`
-	Name           string        `json:"name"`
-	Identifier     string        `json:"identifier"`
-	Description    string        `json:"description"`
-	Channel        string        `json:"channel"`
-	Content        string        `json:"content"`
-	ContentType    string        `json:"contentType"`
-	Variables      []interface{} `json:"variables"`
-	IsDefault      bool          `json:"isDefault"`
-	IsDeleted      bool          `json:"isDeleted"`
-	CreatedAt      string        `json:"createdAt"`
-	UpdatedAt      string        `json:"updatedAt"`
-	ParentId       string        `json:"_parentId"`
+	Id             string           `json:"_id"`
+	OrganizationId string           `json:"_organizationId"`
+	EnvironmentId  string           `json:"_environmentId"`
+	CreatorId      string           `json:"_creatorId"`
+	Name           string           `json:"name"`
+	Identifier     string           `json:"identifier"`
+	Description    string           `json:"description"`
+	Channel        ChannelType      `json:"channel"`
+	Content        string           `json:"content"`
+	ContentType    string           `json:"contentType"`
+	Variables      []LayoutVariable `json:"variables"`
+	IsDefault      bool             `json:"isDefault"`
+	IsDeleted      bool             `json:"isDeleted"`
+	CreatedAt      string           `json:"createdAt"`
+	UpdatedAt      string           `json:"updatedAt"`
+	ParentId       string           `json:"_parentId"`
 }
 type LayoutsResponse struct {
 	TotalCount int              `json:"totalCount"`
@@ -534,6 +958,21 @@ type ChangesGetResponse struct {
 	Page       int                      `json:"page,omitempty"`
 }
 
+const changeHistoryPageSize = 100
+
+type UserRef struct {
+	Id string
+}
+
+type WorkflowChange struct {
+	ChangeId   string
+	CreatedBy  UserRef
+	CreatedAt  time.Time
+	ChangeType string
+	Diff       interface{} // What changed, as reported by the changes API
+	Promoted   bool
+}
+
 type ChangesCountResponse struct {
 	Data int `json:"data"`
 }
@@ -546,9 +985,380 @@ type ChangesApplyResponse struct {
 	Data []ChangesGetResponseData `json:"data,omitempty"`
 }
 
+type CreateTenantRequest struct {
+	Identifier string                 `json:"identifier"`
+	Name       string                 `json:"name"`
+	Data       map[string]interface{} `json:"data,omitempty"`
+}
 
 type UpdateTenantRequest struct {
-	Name 	 string `json:"name"`
-	Data 	 map[string]interface{} `json:"data"`
-	Identifier string `json:"identifier"`
+	Name       string                 `json:"name"`
+	Data       map[string]interface{} `json:"data"`
+	Identifier string                 `json:"identifier"`
+}
+
+type WorkflowTriggerVariable struct {
+	Name string `json:"name"`
+}
+
+type WorkflowTrigger struct {
+	Type                string                    `json:"type"`
+	Identifier          string                    `json:"identifier"`
+	Variables           []WorkflowTriggerVariable `json:"variables"`
+	SubscriberVariables []WorkflowTriggerVariable `json:"subscriberVariables,omitempty"`
+}
+
+type Workflow struct {
+	Id                  string            `json:"_id"`
+	Name                string            `json:"name"`
+	Description         string            `json:"description"`
+	Active              bool              `json:"active"`
+	Draft               bool              `json:"draft"`
+	Critical            bool              `json:"critical"`
+	IsBlueprint         bool              `json:"isBlueprint"`
+	Tags                []string          `json:"tags"`
+	Steps               []interface{}     `json:"steps"`
+	PreferenceSettings  Channel           `json:"preferenceSettings"`
+	Triggers            []WorkflowTrigger `json:"triggers"`
+	NotificationGroupId string            `json:"_notificationGroupId"`
+	OrganizationId      string            `json:"_organizationId"`
+	EnvironmentId       string            `json:"_environmentId"`
+	CreatorId           string            `json:"_creatorId"`
+	ParentId            string            `json:"_parentId,omitempty"`
+	Deleted             bool              `json:"deleted"`
+	DeletedAt           string            `json:"deletedAt,omitempty"`
+	DeletedBy           string            `json:"deletedBy,omitempty"`
+	CreatedAt           string            `json:"createdAt"`
+	UpdatedAt           string            `json:"updatedAt"`
+}
+
+type WorkflowResponse struct {
+	Data Workflow `json:"data"`
+}
+
+type WorkflowListResponse struct {
+	Page       int        `json:"page"`
+	PageSize   int        `json:"pageSize"`
+	TotalCount int        `json:"totalCount"`
+	Data       []Workflow `json:"data"`
+}
+
+type TestSendRequest struct {
+	To      map[string]string      `json:"to"` // Addresses by channel, e.g. {"email": "dev@example.com"}
+	Payload map[string]interface{} `json:"payload,omitempty"`
+	StepId  string                 `json:"stepId,omitempty"` // Only send this step
+}
+
+type TestSendResponse struct {
+	Data EventResponseData `json:"data"`
+}
+
+const bulkUpdateConcurrency = 5
+
+type BulkUpdateError struct {
+	WorkflowID string
+	Error      string
+}
+
+type BulkUpdateResult struct {
+	Updated []string
+	Errors  []BulkUpdateError
+}
+
+// WorkflowChannelStats counts the messages of the notifications scanned by
+// GetWorkflowChannelStats, by channel. Sent and Failed follow the message
+// status, Seen and Read its flags, which are mostly reported for in-app
+// messages. Novu does not report provider delivery on messages, so there is no
+// delivered count.
+type WorkflowChannelStats struct {
+	Total  map[ChannelType]int
+	Sent   map[ChannelType]int
+	Failed map[ChannelType]int
+	Seen   map[ChannelType]int
+	Read   map[ChannelType]int
+
+	Notifications int  // Notifications scanned
+	Truncated     bool // More notifications matched than MaxNotifications
+}
+
+type WorkflowChannelStatsOptions struct {
+	After            time.Time // Only notifications created after, ignored when zero
+	Before           time.Time // Only notifications created before, ignored when zero
+	MaxNotifications int       // Notifications scanned at most, defaults to 1000
+}
+
+type GetWorkflowsFilter struct {
+	Query               string      // Matches the workflow name or trigger identifier
+	Channel             ChannelType // Only workflows with a step on this channel
+	NotificationGroupId string      // Only workflows in this notification group
+}
+
+type CreateWorkflowRequest struct {
+	Name                string        `json:"name"`
+	NotificationGroupId string        `json:"notificationGroupId"`
+	Tags                []string      `json:"tags,omitempty"`
+	Description         string        `json:"description,omitempty"`
+	Steps               []interface{} `json:"steps"`
+	Active              bool          `json:"active,omitempty"`
+	Draft               bool          `json:"draft,omitempty"`
+	Critical            bool          `json:"critical,omitempty"`
+	PreferenceSettings  *Channel      `json:"preferenceSettings,omitempty"`
+}
+
+type UpdateWorkflowRequest struct {
+	Name                string        `json:"name,omitempty"`
+	NotificationGroupId string        `json:"notificationGroupId,omitempty"`
+	Tags                []string      `json:"tags,omitempty"`
+	Description         string        `json:"description,omitempty"`
+	Identifier          string        `json:"identifier,omitempty"`
+	Steps               []interface{} `json:"steps,omitempty"`
+	Critical            *bool         `json:"critical,omitempty"`
+	PreferenceSettings  *Channel      `json:"preferenceSettings,omitempty"`
+}
+
+type UpdateWorkflowStatusRequest struct {
+	Active bool `json:"active"`
+}
+
+type WorkflowVariable struct {
+	Name         string      `json:"name"`
+	Type         string      `json:"type,omitempty"`
+	Required     bool        `json:"required"`
+	DefaultValue interface{} `json:"defaultValue,omitempty"`
+}
+
+type WorkflowVariables struct {
+	Variables []WorkflowVariable `json:"variables"`
+}
+
+type WorkflowVariablesResponse struct {
+	Data WorkflowVariables `json:"data"`
+}
+
+type StepType string
+
+const (
+	StepTypeEmail  StepType = "email"
+	StepTypeSMS    StepType = "sms"
+	StepTypeInApp  StepType = "in_app"
+	StepTypePush   StepType = "push"
+	StepTypeChat   StepType = "chat"
+	StepTypeDigest StepType = "digest"
+	StepTypeDelay  StepType = "delay"
+)
+
+type StepTemplate struct {
+	Id          string      `json:"_id,omitempty"`
+	Type        StepType    `json:"type"`
+	Name        string      `json:"name,omitempty"`
+	Subject     string      `json:"subject,omitempty"`
+	Title       string      `json:"title,omitempty"`
+	Content     interface{} `json:"content,omitempty"`
+	ContentType string      `json:"contentType,omitempty"`
+	Cta         interface{} `json:"cta,omitempty"`
+	Variables   interface{} `json:"variables,omitempty"`
+}
+
+type WorkflowStep struct {
+	StepId           string             `json:"_id,omitempty"`
+	TemplateId       string             `json:"_templateId,omitempty"`
+	Name             string             `json:"name,omitempty"`
+	Type             StepType           `json:"type,omitempty"`
+	Active           bool               `json:"active"`
+	ShouldStopOnFail bool               `json:"shouldStopOnFail"`
+	Filters          []StepFilter       `json:"filters,omitempty"`
+	ReplyCallback    *StepReplyCallback `json:"replyCallback,omitempty"`
+	Template         StepTemplate       `json:"template"`
+}
+
+// FilterOperator is the comparison applied by a FilterPart.
+type FilterOperator string
+
+const (
+	FilterOperatorEqual     FilterOperator = "EQUAL"
+	FilterOperatorNotEqual  FilterOperator = "NOT_EQUAL"
+	FilterOperatorLarger    FilterOperator = "LARGER"
+	FilterOperatorSmaller   FilterOperator = "SMALLER"
+	FilterOperatorContains  FilterOperator = "CONTAINS"
+	FilterOperatorIn        FilterOperator = "IN"
+	FilterOperatorNotIn     FilterOperator = "NOT_IN"
+	FilterOperatorIsDefined FilterOperator = "IS_DEFINED"
+)
+
+// FilterOn is the source a FilterPart reads its field from.
+type FilterOn string
+
+const (
+	FilterOnSubscriber FilterOn = "subscriber"
+	FilterOnPayload    FilterOn = "payload"
+)
+
+// FilterPart is a single condition, e.g. subscriber.phone IS_DEFINED.
+type FilterPart struct {
+	On       FilterOn       `json:"on"`
+	Field    string         `json:"field"`
+	Value    string         `json:"value,omitempty"`
+	Operator FilterOperator `json:"operator"`
+}
+
+// StepFilter groups conditions that decide whether a step runs. Value joins
+// the children with "AND" or "OR"; IsNegated inverts the result.
+type StepFilter struct {
+	IsNegated bool         `json:"isNegated"`
+	Type      string       `json:"type,omitempty"`
+	Value     string       `json:"value"`
+	Children  []FilterPart `json:"children"`
+}
+
+type StepReplyCallback struct {
+	Active bool   `json:"active"`
+	Url    string `json:"url,omitempty"`
+}
+
+type WorkflowStepsResponse struct {
+	Data []WorkflowStep `json:"data"`
+}
+
+type WorkflowStepResponse struct {
+	Data WorkflowStep `json:"data"`
+}
+
+// UpdateStepRequest only sends the fields that are set, so each part of a
+// step can be changed without touching the others.
+type UpdateStepRequest struct {
+	Template      *StepTemplate      `json:"template,omitempty"`
+	Active        *bool              `json:"active,omitempty"`
+	Filters       []StepFilter       `json:"filters,omitempty"`
+	ReplyCallback *StepReplyCallback `json:"replyCallback,omitempty"`
+}
+
+// StepPreview is a step template rendered with sample data. Warnings name the
+// template variables the data did not define.
+type StepPreview struct {
+	Subject  string   `json:"subject,omitempty"`
+	Content  string   `json:"content"`
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+type StepPreviewResponse struct {
+	Data StepPreview `json:"data"`
+}
+
+type NotificationGroupRequest struct {
+	Name string `json:"name"`
+}
+
+type NotificationGroup struct {
+	Id             string `json:"_id"`
+	Name           string `json:"name"`
+	EnvironmentId  string `json:"_environmentId"`
+	OrganizationId string `json:"_organizationId"`
+	ParentId       string `json:"_parentId,omitempty"`
+}
+
+type NotificationGroupResponse struct {
+	Data NotificationGroup `json:"data"`
+}
+
+type NotificationGroupsResponse struct {
+	Data []NotificationGroup `json:"data"`
+}
+
+type ApiKey struct {
+	Key    string `json:"key"`
+	UserId string `json:"_userId"`
+}
+
+type Environment struct {
+	Id             string          `json:"_id"`
+	Name           string          `json:"name"`
+	Identifier     string          `json:"identifier"`
+	OrganizationId string          `json:"_organizationId"`
+	ParentId       string          `json:"_parentId,omitempty"`
+	ApiKeys        []ApiKey        `json:"apiKeys,omitempty"`
+	Dns            *EnvironmentDns `json:"dns,omitempty"`
+	CreatedAt      string          `json:"createdAt,omitempty"`
+	UpdatedAt      string          `json:"updatedAt,omitempty"`
+}
+
+type EnvironmentResponse struct {
+	Data Environment `json:"data"`
+}
+
+type EnvironmentsResponse struct {
+	Data []Environment `json:"data"`
+}
+
+type CreateEnvironmentRequest struct {
+	Name     string `json:"name"`
+	ParentId string `json:"parentId,omitempty"`
+}
+
+type EnvironmentDns struct {
+	InboundParseDomain string `json:"inboundParseDomain,omitempty"`
+	MxRecordConfigured bool   `json:"mxRecordConfigured,omitempty"`
+}
+
+type UpdateEnvironmentRequest struct {
+	Name       string          `json:"name,omitempty"`
+	Identifier string          `json:"identifier,omitempty"`
+	ParentId   string          `json:"parentId,omitempty"`
+	Dns        *EnvironmentDns `json:"dns,omitempty"`
+}
+
+type ApiKeysResponse struct {
+	Data []ApiKey `json:"data"`
+}
+
+type Organization struct {
+	Id        string `json:"_id"`
+	Name      string `json:"name"`
+	Logo      string `json:"logo,omitempty"`
+	Domain    string `json:"domain,omitempty"`
+	CreatedAt string `json:"createdAt,omitempty"`
+	UpdatedAt string `json:"updatedAt,omitempty"`
+}
+
+type OrganizationResponse struct {
+	Data Organization `json:"data"`
+}
+
+type OrganizationsResponse struct {
+	Data []Organization `json:"data"`
+}
+
+type CreateOrganizationRequest struct {
+	Name string `json:"name"`
+}
+
+type UpdateOrganizationRequest struct {
+	Name string `json:"name"`
+}
+
+type InviteMemberRequest struct {
+	Email string `json:"email"`
+}
+
+type MemberUser struct {
+	Id        string `json:"_id"`
+	FirstName string `json:"firstName"`
+	LastName  string `json:"lastName"`
+	Email     string `json:"email"`
+}
+
+type Member struct {
+	Id             string      `json:"_id"`
+	UserId         string      `json:"_userId"`
+	OrganizationId string      `json:"_organizationId"`
+	Roles          []string    `json:"roles"`
+	MemberStatus   string      `json:"memberStatus"`
+	User           *MemberUser `json:"user,omitempty"`
+}
+
+type MemberResponse struct {
+	Data Member `json:"data"`
+}
+
+type MembersResponse struct {
+	Data []Member `json:"data"`
 }
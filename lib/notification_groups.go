@@ -0,0 +1,129 @@
+package lib
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+type INotificationGroups interface {
+	CreateNotificationGroup(ctx context.Context, name string) (*NotificationGroupResponse, error)
+	GetNotificationGroups(ctx context.Context) (*NotificationGroupsResponse, error)
+	GetNotificationGroup(ctx context.Context, notificationGroupId string) (*NotificationGroupResponse, error)
+	UpdateNotificationGroup(ctx context.Context, notificationGroupId string, name string) (*NotificationGroupResponse, error)
+	DeleteNotificationGroup(ctx context.Context, notificationGroupId string) (*Response, error)
+}
+
+type NotificationGroupService service
+
+func (n *NotificationGroupService) CreateNotificationGroup(ctx context.Context, name string) (*NotificationGroupResponse, error) {
+	var resp NotificationGroupResponse
+	URL := n.client.config.BackendURL.JoinPath("notification-groups")
+
+	jsonBody, _ := json.Marshal(NotificationGroupRequest{Name: name})
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, URL.String(), bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = n.client.sendRequest(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+func (n *NotificationGroupService) GetNotificationGroups(ctx context.Context) (*NotificationGroupsResponse, error) {
+	var resp NotificationGroupsResponse
+	URL := n.client.config.BackendURL.JoinPath("notification-groups")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, URL.String(), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = n.client.sendRequest(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+func (n *NotificationGroupService) GetNotificationGroup(ctx context.Context, notificationGroupId string) (*NotificationGroupResponse, error) {
+	var resp NotificationGroupResponse
+	URL := n.client.config.BackendURL.JoinPath("notification-groups", notificationGroupId)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, URL.String(), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = n.client.sendRequest(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// findOrCreateByName returns the id of the group named name, creating the group
+// when there is none.
+func (n *NotificationGroupService) findOrCreateByName(ctx context.Context, name string) (string, error) {
+	groups, err := n.GetNotificationGroups(ctx)
+	if err != nil {
+		return "", err
+	}
+	for _, group := range groups.Data {
+		if group.Name == name {
+			return group.Id, nil
+		}
+	}
+
+	created, err := n.CreateNotificationGroup(ctx, name)
+	if err != nil {
+		return "", err
+	}
+	return created.Data.Id, nil
+}
+
+func (n *NotificationGroupService) UpdateNotificationGroup(ctx context.Context, notificationGroupId string, name string) (*NotificationGroupResponse, error) {
+	var resp NotificationGroupResponse
+	URL := n.client.config.BackendURL.JoinPath("notification-groups", notificationGroupId)
+
+	jsonBody, _ := json.Marshal(NotificationGroupRequest{Name: name})
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, URL.String(), bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = n.client.sendRequest(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+func (n *NotificationGroupService) DeleteNotificationGroup(ctx context.Context, notificationGroupId string) (*Response, error) {
+	var resp Response
+	URL := n.client.config.BackendURL.JoinPath("notification-groups", notificationGroupId)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, URL.String(), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = n.client.sendRequest(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+var _ INotificationGroups = &NotificationGroupService{}
@@ -0,0 +1,116 @@
+package lib_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/novuhq/go-novu/lib"
+	"github.com/stretchr/testify/require"
+)
+
+const notificationGroupId = "62b9b8e3d8c3f7a3c63ad8f1"
+
+var notificationGroupResponse = lib.NotificationGroupResponse{
+	Data: lib.NotificationGroup{
+		Id:             notificationGroupId,
+		Name:           "General",
+		EnvironmentId:  "envId",
+		OrganizationId: "orgId",
+	},
+}
+
+func TestNotificationGroupService_CreateNotificationGroup_Success(t *testing.T) {
+	httpServer := createTestServer(t, TestServerOptions[lib.NotificationGroupRequest, lib.NotificationGroupResponse]{
+		expectedURLPath:    "/v1/notification-groups",
+		expectedSentMethod: http.MethodPost,
+		expectedSentBody:   lib.NotificationGroupRequest{Name: "General"},
+		responseStatusCode: http.StatusCreated,
+		responseBody:       notificationGroupResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.NotificationGroupsApi.CreateNotificationGroup(ctx, "General")
+
+	require.NoError(t, err)
+	require.Equal(t, &notificationGroupResponse, resp)
+}
+
+func TestNotificationGroupService_GetNotificationGroups_Success(t *testing.T) {
+	expectedResponse := lib.NotificationGroupsResponse{
+		Data: []lib.NotificationGroup{notificationGroupResponse.Data},
+	}
+
+	httpServer := createTestServer(t, TestServerOptions[io.Reader, lib.NotificationGroupsResponse]{
+		expectedURLPath:    "/v1/notification-groups",
+		expectedSentMethod: http.MethodGet,
+		expectedSentBody:   http.NoBody,
+		responseStatusCode: http.StatusOK,
+		responseBody:       expectedResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.NotificationGroupsApi.GetNotificationGroups(ctx)
+
+	require.NoError(t, err)
+	require.Equal(t, &expectedResponse, resp)
+}
+
+func TestNotificationGroupService_GetNotificationGroup_Success(t *testing.T) {
+	httpServer := createTestServer(t, TestServerOptions[io.Reader, lib.NotificationGroupResponse]{
+		expectedURLPath:    fmt.Sprintf("/v1/notification-groups/%s", notificationGroupId),
+		expectedSentMethod: http.MethodGet,
+		expectedSentBody:   http.NoBody,
+		responseStatusCode: http.StatusOK,
+		responseBody:       notificationGroupResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.NotificationGroupsApi.GetNotificationGroup(ctx, notificationGroupId)
+
+	require.NoError(t, err)
+	require.Equal(t, &notificationGroupResponse, resp)
+}
+
+func TestNotificationGroupService_UpdateNotificationGroup_Success(t *testing.T) {
+	httpServer := createTestServer(t, TestServerOptions[lib.NotificationGroupRequest, lib.NotificationGroupResponse]{
+		expectedURLPath:    fmt.Sprintf("/v1/notification-groups/%s", notificationGroupId),
+		expectedSentMethod: http.MethodPatch,
+		expectedSentBody:   lib.NotificationGroupRequest{Name: "General"},
+		responseStatusCode: http.StatusOK,
+		responseBody:       notificationGroupResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.NotificationGroupsApi.UpdateNotificationGroup(ctx, notificationGroupId, "General")
+
+	require.NoError(t, err)
+	require.Equal(t, &notificationGroupResponse, resp)
+}
+
+func TestNotificationGroupService_DeleteNotificationGroup_Success(t *testing.T) {
+	expectedResponse := lib.Response{
+		Data: lib.Data{Acknowledged: true, Status: "deleted"},
+	}
+
+	httpServer := createTestServer(t, TestServerOptions[io.Reader, lib.Response]{
+		expectedURLPath:    fmt.Sprintf("/v1/notification-groups/%s", notificationGroupId),
+		expectedSentMethod: http.MethodDelete,
+		expectedSentBody:   http.NoBody,
+		responseStatusCode: http.StatusOK,
+		responseBody:       expectedResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.NotificationGroupsApi.DeleteNotificationGroup(ctx, notificationGroupId)
+
+	require.NoError(t, err)
+	require.Equal(t, &expectedResponse, resp)
+}
@@ -0,0 +1,136 @@
+package lib
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+type INotifications interface {
+	GetNotifications(ctx context.Context, opts GetNotificationsOptions) (*NotificationListResponse, error)
+	GetNotificationStats(ctx context.Context) (*NotificationStatsResponse, error)
+	GetNotificationGraph(ctx context.Context, days int) ([]NotificationGraphPoint, error)
+}
+
+type NotificationService service
+
+// GetNotifications lists the notifications sent in the environment, most
+// recent first, as shown in the activity feed.
+func (n *NotificationService) GetNotifications(ctx context.Context, opts GetNotificationsOptions) (*NotificationListResponse, error) {
+	var resp NotificationListResponse
+	URL := n.client.config.BackendURL.JoinPath("notifications")
+	URL.RawQuery = opts.BuildQuery()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, URL.String(), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = n.client.sendRequest(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetNotificationStats returns how many notifications the current
+// environment sent in the last week and month.
+func (n *NotificationService) GetNotificationStats(ctx context.Context) (*NotificationStatsResponse, error) {
+	var resp NotificationStatsResponse
+	URL := n.client.config.BackendURL.JoinPath("notifications", "stats")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, URL.String(), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = n.client.sendRequest(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetNotificationGraph returns the number of notifications sent on each of the
+// last days days. Days without notifications are not included.
+func (n *NotificationService) GetNotificationGraph(ctx context.Context, days int) ([]NotificationGraphPoint, error) {
+	var resp NotificationGraphResponse
+	URL := n.client.config.BackendURL.JoinPath("notifications", "graph", "stats")
+
+	v := URL.Query()
+	v.Set("days", strconv.Itoa(days))
+	URL.RawQuery = v.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, URL.String(), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = n.client.sendRequest(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Data, nil
+}
+
+func (q GetNotificationsOptions) BuildQuery() string {
+	params := url.Values{}
+	for _, channel := range q.Channels {
+		params.Add("channels", string(channel))
+	}
+	for _, template := range q.Templates {
+		params.Add("templates", template)
+	}
+	for _, email := range q.Emails {
+		params.Add("emails", email)
+	}
+	for _, subscriberId := range q.SubscriberIds {
+		params.Add("subscriberIds", subscriberId)
+	}
+	for _, transactionId := range q.TransactionIds {
+		params.Add("transactionId", transactionId)
+	}
+	if q.Search != "" {
+		params.Add("search", q.Search)
+	}
+	if !q.After.IsZero() {
+		params.Add("after", q.After.UTC().Format(time.RFC3339))
+	}
+	if !q.Before.IsZero() {
+		params.Add("before", q.Before.UTC().Format(time.RFC3339))
+	}
+	if q.Page != 0 {
+		params.Add("page", strconv.Itoa(q.Page))
+	}
+	if q.Limit != 0 {
+		params.Add("limit", strconv.Itoa(q.Limit))
+	}
+	return params.Encode()
+}
+
+// UnmarshalJSON parses the day the API sends as a YYYY-MM-DD string.
+func (p *NotificationGraphPoint) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Day   string `json:"_id"`
+		Count int    `json:"count"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	day, err := time.Parse(time.DateOnly, raw.Day)
+	if err != nil {
+		return err
+	}
+
+	p.Day, p.Count = day, raw.Count
+	return nil
+}
+
+var _ INotifications = &NotificationService{}
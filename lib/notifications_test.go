@@ -0,0 +1,99 @@
+package lib_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/novuhq/go-novu/lib"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationService_GetNotifications_Success(t *testing.T) {
+	expectedResponse := lib.NotificationListResponse{
+		HasMore:  false,
+		PageSize: 10,
+		Page:     1,
+		Data: []lib.NotificationItem{{
+			Id:            "notificationId",
+			TransactionId: "transactionId",
+			Channels:      []lib.StepType{lib.StepTypeEmail},
+			Template:      &lib.NotificationTemplate{Id: workflowId, Name: "workflow"},
+			Subscriber:    &lib.NotificationSubscriber{Id: "subscriberObjectId", SubscriberId: "subscriberId", Email: "john@example.com"},
+			Payload:       map[string]interface{}{"name": "John"},
+			Jobs:          []lib.NotificationJob{{Id: "jobId", Type: lib.StepTypeEmail, Status: "completed"}},
+			CreatedAt:     "2023-03-30T17:52:06.471Z",
+		}},
+	}
+
+	httpServer := createTestServer(t, TestServerOptions[io.Reader, lib.NotificationListResponse]{
+		expectedURLPath:    "/v1/notifications?after=2023-03-01T00%3A00%3A00Z&channels=email&channels=sms&emails=john%40example.com&page=1&search=John&templates=" + workflowId + "&transactionId=transactionId",
+		expectedSentMethod: http.MethodGet,
+		expectedSentBody:   http.NoBody,
+		responseStatusCode: http.StatusOK,
+		responseBody:       expectedResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.NotificationsApi.GetNotifications(ctx, lib.GetNotificationsOptions{
+		Channels:       []lib.StepType{lib.StepTypeEmail, lib.StepTypeSMS},
+		Templates:      []string{workflowId},
+		Emails:         []string{"john@example.com"},
+		TransactionIds: []string{"transactionId"},
+		Search:         "John",
+		After:          time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC),
+		Page:           1,
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, &expectedResponse, resp)
+}
+
+func TestNotificationService_GetNotificationStats_Success(t *testing.T) {
+	expectedResponse := lib.NotificationStatsResponse{
+		Data: lib.NotificationStats{WeeklySent: 12, MonthlySent: 48},
+	}
+
+	httpServer := createTestServer(t, TestServerOptions[io.Reader, lib.NotificationStatsResponse]{
+		expectedURLPath:    "/v1/notifications/stats",
+		expectedSentMethod: http.MethodGet,
+		expectedSentBody:   http.NoBody,
+		responseStatusCode: http.StatusOK,
+		responseBody:       expectedResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.NotificationsApi.GetNotificationStats(ctx)
+
+	require.NoError(t, err)
+	require.Equal(t, &expectedResponse, resp)
+}
+
+func TestNotificationService_GetNotificationGraph_Success(t *testing.T) {
+	httpServer := createTestServer(t, TestServerOptions[io.Reader, map[string]interface{}]{
+		expectedURLPath:    "/v1/notifications/graph/stats?days=7",
+		expectedSentMethod: http.MethodGet,
+		expectedSentBody:   http.NoBody,
+		responseStatusCode: http.StatusOK,
+		responseBody: map[string]interface{}{
+			"data": []map[string]interface{}{
+				{"_id": "2023-03-29", "count": 3, "channels": []string{"email"}},
+				{"_id": "2023-03-30", "count": 5, "channels": []string{"email", "sms"}},
+			},
+		},
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.NotificationsApi.GetNotificationGraph(ctx, 7)
+
+	require.NoError(t, err)
+	require.Equal(t, []lib.NotificationGraphPoint{
+		{Day: time.Date(2023, 3, 29, 0, 0, 0, 0, time.UTC), Count: 3},
+		{Day: time.Date(2023, 3, 30, 0, 0, 0, 0, time.UTC), Count: 5},
+	}, resp)
+}
@@ -1,10 +1,13 @@
 package lib
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
 	"math"
+	"math/rand"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -26,32 +29,61 @@ type RetryConfigType struct {
 	WaitMin      time.Duration // Minimum time to wait
 	WaitMax      time.Duration // Maximum time to wait
 	RetryMax     int           // Maximum number of retries
+	Multiplier   float64       // Backoff growth factor between retries, defaults to 2
+	UseJitter    bool          // Randomize each backoff to avoid synchronized retries
 }
 
 type Config struct {
-	BackendURL  *url.URL
-	HttpClient  *http.Client
-	RetryConfig *RetryConfigType
+	BackendURL      *url.URL
+	HttpClient      *http.Client
+	RetryConfig     *RetryConfigType
+	RateLimitConfig *RateLimitConfig
+	CircuitBreaker  *CircuitBreakerConfig // Fails fast with ErrCircuitOpen after repeated failures, disabled when nil
+	Transport       *TransportOptions     // Ignored when HttpClient is set
+	Middlewares     []Middleware
+	RequestTimeout  time.Duration // Deadline applied to every request, an earlier context deadline still wins
+	Logger          *slog.Logger  // Logs requests, retries and failed responses, silent when nil
+	CacheTTL        time.Duration // Caches workflow and integration reads for this long, disabled when zero
+	// EnvironmentId is sent as the Novu-Environment-Id header when set. Only the
+	// endpoints that honor the header use it; everything else runs in the
+	// environment of the API key, so use that environment's API key to target it.
+	EnvironmentId string
+	// APIVersion pins the API version, e.g. APIVersion20240101, by sending it as
+	// the X-Api-Version header. The server default applies when empty.
+	APIVersion string
+	// IdempotencyKeyPrefix is prepended to the generated Idempotency-Key of
+	// every request, e.g. to tell the keys of several services apart.
+	IdempotencyKeyPrefix string
 }
 
+// APIVersion20240101 is the 2024-01-01 version of the Novu API.
+const APIVersion20240101 = "2024-01-01"
+
 type APIClient struct {
-	apiKey string
-	config *Config
-	common service
+	apiKey  string
+	config  *Config
+	cache   *responseCache
+	breaker *circuitBreaker
+	common  service
 
 	// Api Service
-	BlueprintApi     *BlueprintService
-	ChangesApi       *ChangesService
-	SubscriberApi    *SubscriberService
-	EventApi         *EventService
-	ExecutionsApi    *ExecutionsService
-	MessagesApi      *MessagesService
-	FeedsApi         *FeedsService
-	TopicsApi        *TopicService
-	IntegrationsApi  *IntegrationService
-	InboundParserApi *InboundParserService
-	LayoutApi        *LayoutService
-	TenantApi	       *TenantService
+	BlueprintApi          *BlueprintService
+	ChangesApi            *ChangesService
+	SubscriberApi         *SubscriberService
+	EventApi              *EventService
+	ExecutionsApi         *ExecutionsService
+	MessagesApi           *MessagesService
+	FeedsApi              *FeedsService
+	TopicsApi             *TopicService
+	IntegrationsApi       *IntegrationService
+	InboundParserApi      *InboundParserService
+	LayoutApi             *LayoutService
+	TenantApi             *TenantService
+	WorkflowApi           *WorkflowService
+	NotificationGroupsApi *NotificationGroupService
+	EnvironmentsApi       *EnvironmentService
+	OrganizationsApi      *OrganizationService
+	NotificationsApi      *NotificationService
 }
 
 type service struct {
@@ -63,6 +95,14 @@ func NewAPIClient(apiKey string, cfg *Config) *APIClient {
 
 	if cfg.HttpClient == nil {
 		retyableClient := retryablehttp.NewClient()
+		retyableClient.HTTPClient.Transport = newTransport(cfg.Transport)
+		//hand the last response back instead of a generic "giving up" error
+		retyableClient.ErrorHandler = retryablehttp.PassthroughErrorHandler
+		//the retryable client logs to stderr by default, only log through Config.Logger
+		retyableClient.Logger = nil
+		if cfg.Logger != nil {
+			retyableClient.RequestLogHook = retryLogHook(cfg.Logger)
+		}
 		if cfg.RetryConfig != nil {
 			retyableClient.RetryWaitMin = cfg.RetryConfig.WaitMin
 			retyableClient.RetryWaitMax = cfg.RetryConfig.WaitMax
@@ -80,13 +120,22 @@ func NewAPIClient(apiKey string, cfg *Config) *APIClient {
 				if attemptNum == 0 {
 					return cfg.RetryConfig.InitialDelay //wait for InitialDelay on 1st retry
 				}
-				mult := math.Pow(2, float64(attemptNum)) * float64(min)
+				multiplier := cfg.RetryConfig.Multiplier
+				if multiplier <= 0 {
+					multiplier = 2
+				}
+				mult := math.Pow(multiplier, float64(attemptNum)) * float64(min)
 				sleep := time.Duration(mult)
 				//float64(sleep) != mult is to make sure there is no conversion error
 				//if there is a conversion error, number is huge and we set the sleep to max
 				if float64(sleep) != mult || sleep > max {
 					sleep = max
 				}
+				if cfg.RetryConfig.UseJitter && sleep > 0 {
+					//keep at least half of the computed backoff and randomize the rest
+					half := sleep / 2
+					sleep = half + time.Duration(rand.Int63n(int64(sleep-half)+1))
+				}
 				return sleep
 			}
 		} else {
@@ -95,7 +144,7 @@ func NewAPIClient(apiKey string, cfg *Config) *APIClient {
 		cfg.HttpClient = retyableClient.StandardClient()
 	}
 
-	c := &APIClient{apiKey: apiKey}
+	c := &APIClient{apiKey: apiKey, cache: newResponseCache(cfg.CacheTTL), breaker: newCircuitBreaker(cfg.CircuitBreaker)}
 	c.config = cfg
 	c.common.client = c
 
@@ -112,27 +161,72 @@ func NewAPIClient(apiKey string, cfg *Config) *APIClient {
 	c.LayoutApi = (*LayoutService)(&c.common)
 	c.BlueprintApi = (*BlueprintService)(&c.common)
 	c.TenantApi = (*TenantService)(&c.common)
+	c.WorkflowApi = (*WorkflowService)(&c.common)
+	c.NotificationGroupsApi = (*NotificationGroupService)(&c.common)
+	c.EnvironmentsApi = (*EnvironmentService)(&c.common)
+	c.OrganizationsApi = (*OrganizationService)(&c.common)
+	c.NotificationsApi = (*NotificationService)(&c.common)
 	return c
 }
 
 func (c APIClient) sendRequest(req *http.Request, resp interface{}) (*http.Response, error) {
+	if c.config.RequestTimeout > 0 {
+		ctx, cancel := context.WithTimeout(req.Context(), c.config.RequestTimeout)
+		defer cancel()
+		req = req.WithContext(ctx)
+	}
+
 	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", fmt.Sprintf("ApiKey %s", c.apiKey))
-	req.Header.Set("Idempotency-Key", uuid.New().String())
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", fmt.Sprintf("ApiKey %s", c.apiKey))
+	}
+	if req.Header.Get("Idempotency-Key") == "" {
+		req.Header.Set("Idempotency-Key", c.config.IdempotencyKeyPrefix+uuid.New().String())
+	}
+	if c.config.EnvironmentId != "" {
+		req.Header.Set("Novu-Environment-Id", c.config.EnvironmentId)
+	}
+	if c.config.APIVersion != "" {
+		req.Header.Set("X-Api-Version", c.config.APIVersion)
+	}
 
-	res, err := c.config.HttpClient.Do(req)
+	resource, cacheable := c.cache.resource(req, c.config.BackendURL.String())
+	if cacheable && req.Method == http.MethodGet {
+		if body, ok := c.cache.get(req); ok {
+			return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Request: req}, c.decode(&resp, body)
+		}
+	}
+
+	start := time.Now()
+	res, err := c.breaker.do(req.Context(), func() (*http.Response, error) {
+		return c.doRequest(req)
+	})
+	if errors.Is(err, ErrCircuitOpen) {
+		return nil, err
+	}
+	if cacheable && req.Method != http.MethodGet {
+		c.cache.invalidate(resource)
+	}
 	if err != nil {
+		c.logResponse(req, res, nil, err, time.Since(start))
 		return res, errors.Wrap(err, "failed to execute request")
 	}
 
 	body, _ := io.ReadAll(res.Body)
 	defer res.Body.Close()
+	c.logResponse(req, res, body, nil, time.Since(start))
+
+	if cacheable && req.Method == http.MethodGet && res.StatusCode == http.StatusOK {
+		c.cache.set(req, resource, body)
+	}
 
 	if res.StatusCode >= http.StatusMultipleChoices {
-		return res, errors.Errorf(
-			`request was not successful, status code %d, %s`, res.StatusCode,
-			string(body),
-		)
+		apiErr := newNovuAPIError(res.StatusCode, res.Header, body)
+		if res.StatusCode == http.StatusTooManyRequests {
+			retryAfter, _ := parseRetryAfter(res.Header)
+			return res, &rateLimitError{err: apiErr, retryAfter: retryAfter}
+		}
+		return res, apiErr
 	}
 
 	if string(body) == "" {
@@ -0,0 +1,211 @@
+//go:build integration
+
+package lib_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/novuhq/go-novu/lib"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The integration suite runs against a live Novu environment:
+//
+//	NOVU_API_KEY=... go test -tags integration ./lib/...
+//
+// NOVU_BACKEND_URL points it at a self-hosted instance. Every resource is
+// created with a unique name and removed again when the test returns.
+
+type integrationHarness struct {
+	t        *testing.T
+	ctx      context.Context
+	client   *lib.APIClient
+	cleanups []func(ctx context.Context) error
+}
+
+func newIntegrationHarness(t *testing.T) *integrationHarness {
+	if os.Getenv("NOVU_API_KEY") == "" {
+		t.Skip("NOVU_API_KEY is not set")
+	}
+
+	client, err := lib.NewClientFromEnv()
+	require.NoError(t, err)
+
+	return &integrationHarness{t: t, ctx: context.Background(), client: client}
+}
+
+// cleanup registers fn to run when close is called, in reverse order of
+// registration.
+func (h *integrationHarness) cleanup(fn func(ctx context.Context) error) {
+	h.cleanups = append(h.cleanups, fn)
+}
+
+// close removes the created resources. It is deferred by every test, so it also
+// runs when the test fails or panics.
+func (h *integrationHarness) close() {
+	for i := len(h.cleanups) - 1; i >= 0; i-- {
+		if err := h.cleanups[i](h.ctx); err != nil {
+			h.t.Logf("cleanup failed: %v", err)
+		}
+	}
+}
+
+func (h *integrationHarness) uniqueName(prefix string) string {
+	return prefix + "-" + uuid.NewString()
+}
+
+func (h *integrationHarness) createSubscriber() string {
+	subscriberID := h.uniqueName("go-novu-subscriber")
+	_, err := h.client.SubscriberApi.Identify(h.ctx, subscriberID, lib.SubscriberPayload{Email: "integration@example.com"})
+	require.NoError(h.t, err)
+	h.cleanup(func(ctx context.Context) error {
+		_, err := h.client.SubscriberApi.Delete(ctx, subscriberID)
+		return err
+	})
+	return subscriberID
+}
+
+func (h *integrationHarness) createWorkflow() *lib.WorkflowResponse {
+	groups, err := h.client.NotificationGroupsApi.GetNotificationGroups(h.ctx)
+	require.NoError(h.t, err)
+	require.NotEmpty(h.t, groups.Data, "the environment has no notification groups")
+
+	request, err := lib.NewWorkflowBuilder(h.uniqueName("go-novu-workflow")).
+		SetNotificationGroupId(groups.Data[0].Id).
+		SetActive(true).
+		AddInAppStep("in-app", "Hello {{name}}").
+		Build()
+	require.NoError(h.t, err)
+
+	workflow, err := h.client.WorkflowApi.CreateWorkflow(h.ctx, request)
+	require.NoError(h.t, err)
+	h.cleanup(func(ctx context.Context) error {
+		return h.client.WorkflowApi.DeleteWorkflow(ctx, workflow.Data.Id)
+	})
+	return workflow
+}
+
+func TestIntegration_Workflow(t *testing.T) {
+	h := newIntegrationHarness(t)
+	defer h.close()
+
+	workflow := h.createWorkflow()
+
+	fetched, err := h.client.WorkflowApi.GetWorkflow(h.ctx, workflow.Data.Id)
+	require.NoError(t, err)
+	assert.Equal(t, workflow.Data.Name, fetched.Data.Name)
+
+	updated, err := h.client.WorkflowApi.UpdateWorkflow(h.ctx, workflow.Data.Id, lib.UpdateWorkflowRequest{
+		Name:                workflow.Data.Name,
+		NotificationGroupId: workflow.Data.NotificationGroupId,
+		Description:         "updated by the integration suite",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "updated by the integration suite", updated.Data.Description)
+
+	paused, err := h.client.WorkflowApi.PauseWorkflow(h.ctx, workflow.Data.Id)
+	require.NoError(t, err)
+	assert.False(t, paused.Data.Active)
+}
+
+func TestIntegration_Subscriber(t *testing.T) {
+	h := newIntegrationHarness(t)
+	defer h.close()
+
+	subscriberID := h.createSubscriber()
+
+	_, err := h.client.SubscriberApi.Get(h.ctx, subscriberID)
+	require.NoError(t, err)
+
+	_, err = h.client.SubscriberApi.Update(h.ctx, subscriberID, lib.SubscriberPayload{FirstName: "Integration"})
+	require.NoError(t, err)
+
+	list, err := h.client.SubscriberApi.SearchSubscribers(h.ctx, subscriberID, 0, 10)
+	require.NoError(t, err)
+	require.NotEmpty(t, list.Data)
+	assert.Equal(t, subscriberID, list.Data[0].SubscriberId)
+	assert.Equal(t, "Integration", list.Data[0].FirstName)
+}
+
+func TestIntegration_Topic(t *testing.T) {
+	h := newIntegrationHarness(t)
+	defer h.close()
+
+	subscriberID := h.createSubscriber()
+	topicKey := h.uniqueName("go-novu-topic")
+
+	require.NoError(t, h.client.TopicsApi.Create(h.ctx, topicKey, "Integration topic"))
+	h.cleanup(func(ctx context.Context) error {
+		return h.client.TopicsApi.Delete(ctx, topicKey)
+	})
+
+	_, err := h.client.TopicsApi.AddSubscribers(h.ctx, topicKey, []string{subscriberID})
+	require.NoError(t, err)
+
+	topic, err := h.client.TopicsApi.Get(h.ctx, topicKey)
+	require.NoError(t, err)
+	assert.Contains(t, topic.Subscribers, subscriberID)
+
+	renamed, err := h.client.TopicsApi.Rename(h.ctx, topicKey, "Renamed topic")
+	require.NoError(t, err)
+	assert.Equal(t, "Renamed topic", renamed.Name)
+
+	// the API refuses to delete a topic that still has subscribers
+	_, err = h.client.TopicsApi.RemoveSubscribers(h.ctx, topicKey, []string{subscriberID})
+	require.NoError(t, err)
+}
+
+func TestIntegration_Integration(t *testing.T) {
+	h := newIntegrationHarness(t)
+	defer h.close()
+
+	created, err := h.client.IntegrationsApi.Create(h.ctx, lib.CreateIntegrationRequest{
+		ProviderID:  lib.ProviderIDSendgrid,
+		Channel:     lib.ChannelTypeEmail,
+		Credentials: lib.IntegrationCredentials{ApiKey: "SG.integration", From: "integration@example.com"},
+		Active:      false,
+		Check:       false,
+	})
+	require.NoError(t, err)
+	h.cleanup(func(ctx context.Context) error {
+		_, err := h.client.IntegrationsApi.Delete(ctx, created.Data.Id)
+		return err
+	})
+
+	all, err := h.client.IntegrationsApi.GetAll(h.ctx)
+	require.NoError(t, err)
+	ids := make([]string, 0, len(all.Data))
+	for _, integration := range all.Data {
+		ids = append(ids, integration.Id)
+	}
+	assert.Contains(t, ids, created.Data.Id)
+
+	_, err = h.client.IntegrationsApi.Update(h.ctx, created.Data.Id, lib.UpdateIntegrationRequest{
+		Credentials: lib.IntegrationCredentials{ApiKey: "SG.updated", From: "integration@example.com"},
+	})
+	require.NoError(t, err)
+}
+
+func TestIntegration_EventTrigger(t *testing.T) {
+	h := newIntegrationHarness(t)
+	defer h.close()
+
+	workflow := h.createWorkflow()
+	require.NotEmpty(t, workflow.Data.Triggers)
+	subscriberID := h.createSubscriber()
+
+	resp, err := h.client.EventApi.Trigger(h.ctx, workflow.Data.Triggers[0].Identifier, lib.ITriggerPayloadOptions{
+		To:      subscriberID,
+		Payload: map[string]interface{}{"name": "Integration"},
+	})
+	require.NoError(t, err)
+	assert.True(t, resp.Data.Acknowledged)
+	require.NotEmpty(t, resp.Data.TransactionId)
+
+	_, err = h.client.EventApi.CancelTrigger(h.ctx, resp.Data.TransactionId)
+	require.NoError(t, err)
+}
@@ -166,3 +166,125 @@ func TestError_Retry_With_Default_Config(t *testing.T) {
 	assert.True(t, allElementsSame(idempotencyHeader))
 	assert.Equal(t, len(idempotencyHeader), 1)
 }
+
+func TestRetry_With_Multiplier_And_Jitter(t *testing.T) {
+	reqCount := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		reqCount++
+		if reqCount < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"data":{"acknowledged":true,"status":"processed"}}`))
+	}))
+	defer server.Close()
+
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{
+		BackendURL: lib.MustParseURL(server.URL),
+		RetryConfig: &lib.RetryConfigType{
+			RetryMax:   3,
+			WaitMin:    time.Millisecond,
+			WaitMax:    10 * time.Millisecond,
+			Multiplier: 3,
+			UseJitter:  true,
+		},
+	})
+
+	resp, err := c.EventApi.Trigger(context.Background(), "event", lib.ITriggerPayloadOptions{To: "subscriberId"})
+	require.NoError(t, err)
+	assert.True(t, resp.Data.Acknowledged)
+	assert.Equal(t, 3, reqCount)
+}
+
+type countingTransport struct {
+	calls int
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.calls++
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestCustom_HttpClient_Is_Used(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"data":{"acknowledged":true,"status":"processed"}}`))
+	}))
+	defer server.Close()
+
+	transport := &countingTransport{}
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{
+		BackendURL: lib.MustParseURL(server.URL),
+		HttpClient: &http.Client{Transport: transport},
+	})
+
+	_, err := c.EventApi.Trigger(context.Background(), "event", lib.ITriggerPayloadOptions{To: "subscriberId"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, transport.calls)
+}
+
+func TestEnvironmentId_Header(t *testing.T) {
+	var environmentHeader string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		environmentHeader = req.Header.Get("Novu-Environment-Id")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"data":{"acknowledged":true,"status":"processed"}}`))
+	}))
+	defer server.Close()
+
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{
+		BackendURL:    lib.MustParseURL(server.URL),
+		EnvironmentId: "6425cb40d22507199a000003",
+	})
+
+	_, err := c.EventApi.Trigger(context.Background(), "event", lib.ITriggerPayloadOptions{To: "subscriberId"})
+	require.NoError(t, err)
+	assert.Equal(t, "6425cb40d22507199a000003", environmentHeader)
+}
+
+func TestAPIVersion_Header(t *testing.T) {
+	var versionHeaders [][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		versionHeaders = append(versionHeaders, req.Header.Values("X-Api-Version"))
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"data":{"acknowledged":true,"status":"processed"}}`))
+	}))
+	defer server.Close()
+
+	pinned := lib.NewAPIClient(novuApiKey, &lib.Config{
+		BackendURL: lib.MustParseURL(server.URL),
+		APIVersion: lib.APIVersion20240101,
+	})
+	unpinned := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(server.URL)})
+
+	_, err := pinned.EventApi.Trigger(context.Background(), "event", lib.ITriggerPayloadOptions{To: "subscriberId"})
+	require.NoError(t, err)
+	_, err = unpinned.EventApi.Trigger(context.Background(), "event", lib.ITriggerPayloadOptions{To: "subscriberId"})
+	require.NoError(t, err)
+
+	require.Len(t, versionHeaders, 2)
+	assert.Equal(t, []string{"2024-01-01"}, versionHeaders[0])
+	assert.Empty(t, versionHeaders[1])
+}
+
+func TestRequestTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		select {
+		case <-req.Context().Done():
+		case <-time.After(200 * time.Millisecond):
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{
+		BackendURL:     lib.MustParseURL(server.URL),
+		RequestTimeout: 20 * time.Millisecond,
+	})
+
+	start := time.Now()
+	_, err := c.EventApi.Trigger(context.Background(), "event", lib.ITriggerPayloadOptions{To: "subscriberId"})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), 200*time.Millisecond)
+}
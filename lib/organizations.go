@@ -0,0 +1,150 @@
+package lib
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+type IOrganizations interface {
+	CreateOrganization(ctx context.Context, name string) (*OrganizationResponse, error)
+	GetCurrentOrganization(ctx context.Context) (*OrganizationResponse, error)
+	GetOrganizations(ctx context.Context) (*OrganizationsResponse, error)
+	UpdateOrganization(ctx context.Context, request UpdateOrganizationRequest) (*OrganizationResponse, error)
+	InviteMember(ctx context.Context, email string) error
+	GetMembers(ctx context.Context) (*MembersResponse, error)
+	DeleteMember(ctx context.Context, memberId string) (*MemberResponse, error)
+}
+
+type OrganizationService service
+
+func (o *OrganizationService) CreateOrganization(ctx context.Context, name string) (*OrganizationResponse, error) {
+	var resp OrganizationResponse
+	URL := o.client.config.BackendURL.JoinPath("organizations")
+
+	jsonBody, _ := json.Marshal(CreateOrganizationRequest{Name: name})
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, URL.String(), bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = o.client.sendRequest(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetCurrentOrganization returns the organization the configured API key belongs to.
+func (o *OrganizationService) GetCurrentOrganization(ctx context.Context) (*OrganizationResponse, error) {
+	var resp OrganizationResponse
+	URL := o.client.config.BackendURL.JoinPath("organizations", "me")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, URL.String(), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = o.client.sendRequest(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+func (o *OrganizationService) GetOrganizations(ctx context.Context) (*OrganizationsResponse, error) {
+	var resp OrganizationsResponse
+	URL := o.client.config.BackendURL.JoinPath("organizations")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, URL.String(), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = o.client.sendRequest(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// UpdateOrganization updates the current organization. The API resolves the
+// organization from the API key, so no id is sent.
+func (o *OrganizationService) UpdateOrganization(ctx context.Context, request UpdateOrganizationRequest) (*OrganizationResponse, error) {
+	var resp OrganizationResponse
+	URL := o.client.config.BackendURL.JoinPath("organizations")
+
+	jsonBody, _ := json.Marshal(request)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, URL.String(), bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = o.client.sendRequest(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+func (o *OrganizationService) InviteMember(ctx context.Context, email string) error {
+	var resp interface{}
+	URL := o.client.config.BackendURL.JoinPath("invites")
+
+	jsonBody, _ := json.Marshal(InviteMemberRequest{Email: email})
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, URL.String(), bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return err
+	}
+
+	_, err = o.client.sendRequest(req, &resp)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *OrganizationService) GetMembers(ctx context.Context) (*MembersResponse, error) {
+	var resp MembersResponse
+	URL := o.client.config.BackendURL.JoinPath("organizations", "members")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, URL.String(), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = o.client.sendRequest(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+func (o *OrganizationService) DeleteMember(ctx context.Context, memberId string) (*MemberResponse, error) {
+	var resp MemberResponse
+	URL := o.client.config.BackendURL.JoinPath("organizations", "members", memberId)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, URL.String(), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = o.client.sendRequest(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+var _ IOrganizations = &OrganizationService{}
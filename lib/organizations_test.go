@@ -0,0 +1,154 @@
+package lib_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/novuhq/go-novu/lib"
+	"github.com/stretchr/testify/require"
+)
+
+const memberId = "6425cb40d22507199a000010"
+
+var organizationResponse = lib.OrganizationResponse{
+	Data: lib.Organization{
+		Id:   "6425cb40d22507199a000001",
+		Name: "Acme",
+	},
+}
+
+var member = lib.Member{
+	Id:             memberId,
+	UserId:         "userId",
+	OrganizationId: "6425cb40d22507199a000001",
+	Roles:          []string{"admin"},
+	MemberStatus:   "active",
+	User:           &lib.MemberUser{Id: "userId", FirstName: "John", LastName: "Doe", Email: "john@example.com"},
+}
+
+func TestOrganizationService_CreateOrganization_Success(t *testing.T) {
+	httpServer := createTestServer(t, TestServerOptions[lib.CreateOrganizationRequest, lib.OrganizationResponse]{
+		expectedURLPath:    "/v1/organizations",
+		expectedSentMethod: http.MethodPost,
+		expectedSentBody:   lib.CreateOrganizationRequest{Name: "Acme"},
+		responseStatusCode: http.StatusCreated,
+		responseBody:       organizationResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.OrganizationsApi.CreateOrganization(ctx, "Acme")
+
+	require.NoError(t, err)
+	require.Equal(t, &organizationResponse, resp)
+}
+
+func TestOrganizationService_GetCurrentOrganization_Success(t *testing.T) {
+	httpServer := createTestServer(t, TestServerOptions[io.Reader, lib.OrganizationResponse]{
+		expectedURLPath:    "/v1/organizations/me",
+		expectedSentMethod: http.MethodGet,
+		expectedSentBody:   http.NoBody,
+		responseStatusCode: http.StatusOK,
+		responseBody:       organizationResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.OrganizationsApi.GetCurrentOrganization(ctx)
+
+	require.NoError(t, err)
+	require.Equal(t, &organizationResponse, resp)
+}
+
+func TestOrganizationService_GetOrganizations_Success(t *testing.T) {
+	expectedResponse := lib.OrganizationsResponse{Data: []lib.Organization{organizationResponse.Data}}
+
+	httpServer := createTestServer(t, TestServerOptions[io.Reader, lib.OrganizationsResponse]{
+		expectedURLPath:    "/v1/organizations",
+		expectedSentMethod: http.MethodGet,
+		expectedSentBody:   http.NoBody,
+		responseStatusCode: http.StatusOK,
+		responseBody:       expectedResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.OrganizationsApi.GetOrganizations(ctx)
+
+	require.NoError(t, err)
+	require.Equal(t, &expectedResponse, resp)
+}
+
+func TestOrganizationService_UpdateOrganization_Success(t *testing.T) {
+	httpServer := createTestServer(t, TestServerOptions[lib.UpdateOrganizationRequest, lib.OrganizationResponse]{
+		expectedURLPath:    "/v1/organizations",
+		expectedSentMethod: http.MethodPatch,
+		expectedSentBody:   lib.UpdateOrganizationRequest{Name: "Acme"},
+		responseStatusCode: http.StatusOK,
+		responseBody:       organizationResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.OrganizationsApi.UpdateOrganization(ctx, lib.UpdateOrganizationRequest{Name: "Acme"})
+
+	require.NoError(t, err)
+	require.Equal(t, &organizationResponse, resp)
+}
+
+func TestOrganizationService_InviteMember_Success(t *testing.T) {
+	httpServer := createTestServer(t, TestServerOptions[lib.InviteMemberRequest, map[string]interface{}]{
+		expectedURLPath:    "/v1/invites",
+		expectedSentMethod: http.MethodPost,
+		expectedSentBody:   lib.InviteMemberRequest{Email: "john@example.com"},
+		responseStatusCode: http.StatusCreated,
+		responseBody:       map[string]interface{}{"data": "token"},
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	err := c.OrganizationsApi.InviteMember(ctx, "john@example.com")
+
+	require.NoError(t, err)
+}
+
+func TestOrganizationService_GetMembers_Success(t *testing.T) {
+	expectedResponse := lib.MembersResponse{Data: []lib.Member{member}}
+
+	httpServer := createTestServer(t, TestServerOptions[io.Reader, lib.MembersResponse]{
+		expectedURLPath:    "/v1/organizations/members",
+		expectedSentMethod: http.MethodGet,
+		expectedSentBody:   http.NoBody,
+		responseStatusCode: http.StatusOK,
+		responseBody:       expectedResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.OrganizationsApi.GetMembers(ctx)
+
+	require.NoError(t, err)
+	require.Equal(t, &expectedResponse, resp)
+}
+
+func TestOrganizationService_DeleteMember_Success(t *testing.T) {
+	expectedResponse := lib.MemberResponse{Data: member}
+
+	httpServer := createTestServer(t, TestServerOptions[io.Reader, lib.MemberResponse]{
+		expectedURLPath:    fmt.Sprintf("/v1/organizations/members/%s", memberId),
+		expectedSentMethod: http.MethodDelete,
+		expectedSentBody:   http.NoBody,
+		responseStatusCode: http.StatusOK,
+		responseBody:       expectedResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.OrganizationsApi.DeleteMember(ctx, memberId)
+
+	require.NoError(t, err)
+	require.Equal(t, &expectedResponse, resp)
+}
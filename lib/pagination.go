@@ -0,0 +1,159 @@
+package lib
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ErrNoMorePages is returned by Paginator.Next once every item has been consumed.
+var ErrNoMorePages = errors.New("no more pages")
+
+// PageFetcher loads a single page of results and returns the items on that
+// page together with the total number of items reported by the server.
+type PageFetcher[T any] func(ctx context.Context, page int) ([]T, int, error)
+
+// Paginator walks through a paginated endpoint one item at a time, fetching
+// the next page whenever the current one is exhausted.
+type Paginator[T any] struct {
+	fetch   PageFetcher[T]
+	page    int
+	buffer  []T
+	fetched int
+	total   int
+	started bool
+}
+
+func NewPaginator[T any](fetch PageFetcher[T]) *Paginator[T] {
+	return &Paginator[T]{fetch: fetch}
+}
+
+// HasMore reports whether Next may return another item. It is always true
+// before the first page has been fetched.
+func (p *Paginator[T]) HasMore() bool {
+	return !p.started || len(p.buffer) > 0 || p.fetched < p.total
+}
+
+func (p *Paginator[T]) Next(ctx context.Context) (T, error) {
+	var item T
+
+	if len(p.buffer) == 0 {
+		if !p.HasMore() {
+			return item, ErrNoMorePages
+		}
+
+		items, total, err := p.fetch(ctx, p.page)
+		if err != nil {
+			return item, err
+		}
+
+		p.started = true
+		p.page++
+		p.fetched += len(items)
+		p.total = total
+		p.buffer = items
+
+		if len(items) == 0 {
+			p.total = p.fetched
+			return item, ErrNoMorePages
+		}
+	}
+
+	item = p.buffer[0]
+	p.buffer = p.buffer[1:]
+
+	return item, nil
+}
+
+// PagedResult is a single page of a list endpoint. NextCursor is empty on the
+// last page; otherwise pass it as PageOptions.FetchNextPage to load the page
+// that follows.
+type PagedResult[T any] struct {
+	Data       []T
+	TotalCount int
+	NextCursor string
+	HasMore    bool
+}
+
+// PageOptions selects the page of a list endpoint. When FetchNextPage is set,
+// Page and Limit are ignored and the page the cursor refers to is loaded.
+type PageOptions struct {
+	Page          int
+	Limit         int
+	FetchNextPage string
+}
+
+type pagedResponse[T any] struct {
+	Page       int  `json:"page"`
+	PageSize   int  `json:"pageSize"`
+	TotalCount int  `json:"totalCount"`
+	HasMore    bool `json:"hasMore"`
+	Data       []T  `json:"data"`
+}
+
+// fetchPage loads a page of URL. The next cursor comes from the Link
+// rel="next" response header when the server sends one, and from the page and
+// limit parameters otherwise. Cursors only carry query parameters, so a
+// cursor can never send the request to another host.
+func fetchPage[T any](ctx context.Context, c *APIClient, URL *url.URL, opts PageOptions) (*PagedResult[T], error) {
+	var resp pagedResponse[T]
+
+	if opts.FetchNextPage != "" {
+		URL.RawQuery = opts.FetchNextPage
+	} else {
+		v := URL.Query()
+		v.Set("page", strconv.Itoa(opts.Page))
+		if opts.Limit > 0 {
+			v.Set("limit", strconv.Itoa(opts.Limit))
+		}
+		URL.RawQuery = v.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, URL.String(), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.sendRequest(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PagedResult[T]{Data: resp.Data, TotalCount: resp.TotalCount, HasMore: resp.HasMore}
+	if next := nextLink(res.Header); next != nil {
+		result.NextCursor = next.RawQuery
+		result.HasMore = true
+	} else if !result.HasMore && resp.PageSize > 0 {
+		result.HasMore = (resp.Page+1)*resp.PageSize < resp.TotalCount
+	}
+	if result.NextCursor == "" && result.HasMore {
+		v := URL.Query()
+		v.Set("page", strconv.Itoa(resp.Page+1))
+		result.NextCursor = v.Encode()
+	}
+
+	return result, nil
+}
+
+// nextLink returns the rel="next" target of the Link header, if any.
+func nextLink(header http.Header) *url.URL {
+	for _, value := range header.Values("Link") {
+		for _, link := range strings.Split(value, ",") {
+			parts := strings.Split(link, ";")
+			target := strings.Trim(strings.TrimSpace(parts[0]), "<>")
+			for _, param := range parts[1:] {
+				param = strings.ReplaceAll(strings.TrimSpace(param), " ", "")
+				if param == `rel="next"` || param == "rel=next" {
+					if u, err := url.Parse(target); err == nil {
+						return u
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
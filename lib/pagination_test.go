@@ -0,0 +1,132 @@
+package lib_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/novuhq/go-novu/lib"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaginator_Next_WalksAllPages(t *testing.T) {
+	pages := [][]int{{1, 2}, {3, 4}, {5}}
+	var requestedPages []int
+
+	p := lib.NewPaginator(func(ctx context.Context, page int) ([]int, int, error) {
+		requestedPages = append(requestedPages, page)
+		return pages[page], 5, nil
+	})
+
+	var items []int
+	for p.HasMore() {
+		item, err := p.Next(context.Background())
+		require.NoError(t, err)
+		items = append(items, item)
+	}
+
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, items)
+	assert.Equal(t, []int{0, 1, 2}, requestedPages)
+
+	_, err := p.Next(context.Background())
+	assert.ErrorIs(t, err, lib.ErrNoMorePages)
+}
+
+func TestPaginator_Next_StopsOnEmptyPage(t *testing.T) {
+	p := lib.NewPaginator(func(ctx context.Context, page int) ([]int, int, error) {
+		return []int{}, 0, nil
+	})
+
+	_, err := p.Next(context.Background())
+	assert.ErrorIs(t, err, lib.ErrNoMorePages)
+	assert.False(t, p.HasMore())
+}
+
+func TestPaginator_Next_ReturnsFetchError(t *testing.T) {
+	fetchErr := errors.New("boom")
+	p := lib.NewPaginator(func(ctx context.Context, page int) ([]int, int, error) {
+		return nil, 0, fetchErr
+	})
+
+	_, err := p.Next(context.Background())
+	assert.ErrorIs(t, err, fetchErr)
+}
+
+func TestWorkflowService_IterateWorkflows_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		assert.Equal(t, "1", r.URL.Query().Get("limit"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"page":` + strconv.Itoa(page) + `,"pageSize":1,"totalCount":2,"data":[{"_id":"workflow-` + strconv.Itoa(page) + `"}]}`))
+	}))
+	defer server.Close()
+
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(server.URL)})
+	it := c.WorkflowApi.IterateWorkflows(1)
+
+	var ids []string
+	for it.HasMore() {
+		workflow, err := it.Next(context.Background())
+		require.NoError(t, err)
+		ids = append(ids, workflow.Id)
+	}
+
+	assert.Equal(t, []string{"workflow-0", "workflow-1"}, ids)
+}
+
+func TestSubscriberService_ListPage_FollowsLinkHeader(t *testing.T) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		queries = append(queries, req.URL.RawQuery)
+		if req.URL.Query().Get("cursor") == "" {
+			w.Header().Set("Link", `<https://api.novu.co/v1/subscribers?cursor=abc&limit=1>; rel="next"`)
+			w.Write([]byte(`{"data":[{"subscriberId":"first"}]}`))
+			return
+		}
+		w.Write([]byte(`{"data":[{"subscriberId":"second"}]}`))
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(server.URL)})
+
+	first, err := c.SubscriberApi.ListPage(ctx, lib.PageOptions{Limit: 1})
+	require.NoError(t, err)
+	assert.True(t, first.HasMore)
+	assert.Equal(t, "cursor=abc&limit=1", first.NextCursor)
+
+	second, err := c.SubscriberApi.ListPage(ctx, lib.PageOptions{FetchNextPage: first.NextCursor})
+	require.NoError(t, err)
+	assert.Equal(t, "second", second.Data[0].SubscriberId)
+	assert.False(t, second.HasMore)
+	assert.Empty(t, second.NextCursor)
+
+	assert.Equal(t, []string{"limit=1&page=0", "cursor=abc&limit=1"}, queries)
+}
+
+func TestWorkflowService_GetWorkflowsPage_FallsBackToPageNumbers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		page, _ := strconv.Atoi(req.URL.Query().Get("page"))
+		w.Write([]byte(`{"page":` + strconv.Itoa(page) + `,"pageSize":2,"totalCount":3,"data":[{"_id":"workflow"}]}`))
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(server.URL)})
+
+	first, err := c.WorkflowApi.GetWorkflowsPage(ctx, lib.PageOptions{Limit: 2})
+	require.NoError(t, err)
+	assert.True(t, first.HasMore)
+	assert.Equal(t, "limit=2&page=1", first.NextCursor)
+	assert.Equal(t, 3, first.TotalCount)
+
+	second, err := c.WorkflowApi.GetWorkflowsPage(ctx, lib.PageOptions{FetchNextPage: first.NextCursor})
+	require.NoError(t, err)
+	assert.False(t, second.HasMore)
+	assert.Empty(t, second.NextCursor)
+}
@@ -0,0 +1,129 @@
+package lib
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// defaultRateLimitMaxWait bounds the total time spent waiting on 429 responses
+// when RateLimitConfig.MaxWait is not set.
+const defaultRateLimitMaxWait = time.Minute
+
+type RateLimitConfig struct {
+	WaitOnRateLimit bool          // Sleep until the rate limit window resets and retry the request
+	MaxWait         time.Duration // Maximum total time to wait for a single request, defaults to one minute
+}
+
+// RateLimitError is returned when the API responds with 429 Too Many Requests.
+// RetryAfter reports how long the server asked the client to wait, or zero if
+// the response carried no rate limit headers.
+type RateLimitError interface {
+	error
+	RetryAfter() time.Duration
+}
+
+type rateLimitError struct {
+	err        error
+	retryAfter time.Duration
+}
+
+func (e *rateLimitError) Error() string {
+	return e.err.Error()
+}
+
+func (e *rateLimitError) Unwrap() error {
+	return e.err
+}
+
+func (e *rateLimitError) RetryAfter() time.Duration {
+	return e.retryAfter
+}
+
+// parseRetryAfter reads the wait duration from the Retry-After header, either
+// in seconds or as an HTTP date, falling back to X-RateLimit-Reset which may be
+// a delay in seconds or a unix timestamp.
+func parseRetryAfter(header http.Header) (time.Duration, bool) {
+	if v := header.Get("Retry-After"); v != "" {
+		if seconds, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return nonNegative(time.Duration(seconds) * time.Second), true
+		}
+		if date, err := http.ParseTime(v); err == nil {
+			return nonNegative(time.Until(date)), true
+		}
+	}
+
+	if v := header.Get("X-RateLimit-Reset"); v != "" {
+		if reset, err := strconv.ParseInt(v, 10, 64); err == nil {
+			// values this large can only be unix timestamps
+			if reset > 1_000_000_000 {
+				return nonNegative(time.Until(time.Unix(reset, 0))), true
+			}
+			return nonNegative(time.Duration(reset) * time.Second), true
+		}
+	}
+
+	return 0, false
+}
+
+func nonNegative(d time.Duration) time.Duration {
+	if d < 0 {
+		return 0
+	}
+	return d
+}
+
+// doRequest executes the request and, when waiting on rate limits is enabled,
+// sleeps for the advertised window and retries on 429 responses until the
+// configured maximum wait is exhausted or the request context is done.
+func (c APIClient) doRequest(req *http.Request) (*http.Response, error) {
+	var waited time.Duration
+
+	for {
+		res, err := c.roundTrip(req)
+		if err != nil || res.StatusCode != http.StatusTooManyRequests {
+			return res, err
+		}
+
+		cfg := c.config.RateLimitConfig
+		if cfg == nil || !cfg.WaitOnRateLimit {
+			return res, nil
+		}
+
+		wait, ok := parseRetryAfter(res.Header)
+		if !ok {
+			return res, nil
+		}
+
+		maxWait := cfg.MaxWait
+		if maxWait <= 0 {
+			maxWait = defaultRateLimitMaxWait
+		}
+		if waited+wait > maxWait {
+			return res, nil
+		}
+
+		// the body has to be replayable to send the request again
+		if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+			return res, nil
+		}
+
+		res.Body.Close()
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+		waited += wait
+
+		if req.GetBody != nil {
+			req.Body, err = req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package lib_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/novuhq/go-novu/lib"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimit_ReturnsRateLimitError(t *testing.T) {
+	reqCount := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		reqCount++
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"message":"ThrottlerException: Too Many Requests"}`))
+	}))
+	defer server.Close()
+
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(server.URL)})
+	_, err := c.EventApi.Trigger(context.Background(), "event", lib.ITriggerPayloadOptions{To: "subscriberId"})
+
+	var rateLimitErr lib.RateLimitError
+	require.True(t, errors.As(err, &rateLimitErr))
+	assert.Equal(t, 30*time.Second, rateLimitErr.RetryAfter())
+	assert.Contains(t, err.Error(), "status code 429")
+	assert.Equal(t, 1, reqCount)
+}
+
+func TestRateLimit_WaitsAndRetries(t *testing.T) {
+	var receivedBodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		buf := make([]byte, req.ContentLength)
+		req.Body.Read(buf)
+		receivedBodies = append(receivedBodies, string(buf))
+
+		if len(receivedBodies) == 1 {
+			w.Header().Set("X-RateLimit-Reset", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"data":{"acknowledged":true,"status":"processed"}}`))
+	}))
+	defer server.Close()
+
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{
+		BackendURL:      lib.MustParseURL(server.URL),
+		RateLimitConfig: &lib.RateLimitConfig{WaitOnRateLimit: true, MaxWait: time.Second},
+	})
+	resp, err := c.EventApi.Trigger(context.Background(), "event", lib.ITriggerPayloadOptions{To: "subscriberId"})
+
+	require.NoError(t, err)
+	assert.True(t, resp.Data.Acknowledged)
+	require.Len(t, receivedBodies, 2)
+	assert.NotEmpty(t, receivedBodies[0])
+	assert.Equal(t, receivedBodies[0], receivedBodies[1])
+}
+
+func TestRateLimit_DoesNotWaitBeyondMaxWait(t *testing.T) {
+	reqCount := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		reqCount++
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{
+		BackendURL:      lib.MustParseURL(server.URL),
+		RateLimitConfig: &lib.RateLimitConfig{WaitOnRateLimit: true, MaxWait: time.Second},
+	})
+	_, err := c.EventApi.Trigger(context.Background(), "event", lib.ITriggerPayloadOptions{To: "subscriberId"})
+
+	var rateLimitErr lib.RateLimitError
+	require.True(t, errors.As(err, &rateLimitErr))
+	assert.Equal(t, time.Minute, rateLimitErr.RetryAfter())
+	assert.Equal(t, 1, reqCount)
+}
+
+func TestRateLimit_WaitAbortsOnContextCancel(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{
+		BackendURL:      lib.MustParseURL(server.URL),
+		RateLimitConfig: &lib.RateLimitConfig{WaitOnRateLimit: true},
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := c.EventApi.Trigger(ctx, "event", lib.ITriggerPayloadOptions{To: "subscriberId"})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -8,22 +8,61 @@ import (
 	"io"
 	"net/http"
 	"strconv"
+	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 )
 
+// ErrBulkDeleteTooLarge is returned when more than MaxBulkDeleteSubscribers
+// subscribers are deleted at once.
+var ErrBulkDeleteTooLarge = errors.New("too many subscribers to delete at once")
+
+// ErrSubscriberNotFound is returned by DeleteAllMessages and MergeSubscribers
+// when a subscriber does not exist.
+var ErrSubscriberNotFound = errors.New("subscriber not found")
+
+// deleteMessagesPageSize is the number of messages listed at a time by
+// DeleteAllMessages.
+const deleteMessagesPageSize = 100
+
+// mergeTopicsPageSize is the number of topics listed at a time by
+// MergeSubscribers.
+const mergeTopicsPageSize = 100
+
 type ISubscribers interface {
 	Identify(ctx context.Context, subscriberID string, data interface{}) (SubscriberResponse, error)
+	Upsert(ctx context.Context, subscriberID string, data interface{}) (SubscriberResponse, error)
 	BulkCreate(ctx context.Context, subscribers SubscriberBulkPayload) (SubscriberBulkCreateResponse, error)
 	Get(ctx context.Context, subscriberID string) (SubscriberResponse, error)
+	List(ctx context.Context, opts *SubscriberListOptions) (*SubscriberListResponse, error)
+	SearchSubscribers(ctx context.Context, query string, page int, limit int) (*SubscriberListResponse, error)
+	ListPage(ctx context.Context, opts PageOptions) (*PagedResult[Subscriber], error)
 	Update(ctx context.Context, subscriberID string, data interface{}) (SubscriberResponse, error)
 	UpdateCredentials(ctx context.Context, subscriberID string, payload SubscriberCredentialPayload) (SubscriberResponse, error)
+	SetCredentials(ctx context.Context, subscriberID string, payload SubscriberCredentialPayload) (SubscriberResponse, error)
+	GetCredentials(ctx context.Context, subscriberID string) ([]SubscriberChannelCredential, error)
+	DeleteCredentials(ctx context.Context, subscriberID string, providerId string) error
 	Delete(ctx context.Context, subscriberID string) (SubscriberResponse, error)
+	BulkDelete(ctx context.Context, subscriberIDs []string) (*BulkDeleteResult, error)
+	DeleteAllMessages(ctx context.Context, subscriberID string) error
+	MergeSubscribers(ctx context.Context, primarySubscriberID string, secondarySubscriberID string) (*MergeSubscribersResult, error)
+	UpdateOnlineStatus(ctx context.Context, subscriberID string, isOnline bool, lastOnlineAt *time.Time) (SubscriberResponse, error)
 	GetNotificationFeed(ctx context.Context, subscriberID string, opts *SubscriberNotificationFeedOptions) (*SubscriberNotificationFeedResponse, error)
+	GetNotificationActivity(ctx context.Context, subscriberID string, page int, limit int) (*NotificationListResponse, error)
 	GetUnseenCount(ctx context.Context, subscriberID string, opts *SubscriberUnseenCountOptions) (*SubscriberUnseenCountResponse, error)
+	GetUnreadCount(ctx context.Context, subscriberID string, opts *SubscriberUnreadCountOptions) (*SubscriberUnreadCountResponse, error)
 	MarkMessageSeen(ctx context.Context, subscriberID string, opts SubscriberMarkMessageSeenOptions) (*SubscriberNotificationFeedResponse, error)
+	MarkMessagesSeen(ctx context.Context, subscriberID string, messageIDs []string) (*SubscriberNotificationFeedResponse, error)
+	MarkMessagesRead(ctx context.Context, subscriberID string, messageIDs []string) (*SubscriberNotificationFeedResponse, error)
+	MarkAllMessagesRead(ctx context.Context, subscriberID string, feedIdentifier string, read bool) (*MarkAllMessagesResponse, error)
 	GetPreferences(ctx context.Context, subscriberID string) (*SubscriberPreferencesResponse, error)
-	UpdatePreferences(ctx context.Context, subscriberID string, templateId string, opts *UpdateSubscriberPreferencesOptions) (*SubscriberPreferencesResponse, error)
+	GetTopics(ctx context.Context, subscriberID string, page int, limit int) (*ListTopicsResponse, error)
+	UpdatePreferences(ctx context.Context, subscriberID string, templateId string, opts *UpdateSubscriberPreferencesOptions) (*SubscriberPreferenceResponse, error)
+	GetGlobalPreferences(ctx context.Context, subscriberID string) (*SubscriberPreferencesResponse, error)
+	UpdateGlobalPreferences(ctx context.Context, subscriberID string, opts UpdateSubscriberGlobalPreferencesOptions) (*SubscriberPreferenceResponse, error)
+	UpdateAllSubscriberPreferences(ctx context.Context, subscriberID string, preferences []UpdatePreferenceRequest) ([]SubscriberPreference, error)
+	UpdateGlobalChannelPreference(ctx context.Context, subscriberID string, channel ChannelType, enabled bool) (*SubscriberPreferenceResponse, error)
 }
 
 type SubscriberService service
@@ -52,6 +91,20 @@ func (s *SubscriberService) Identify(ctx context.Context, subscriberID string, d
 	return resp, nil
 }
 
+// Upsert makes sure the subscriber exists with data, whether or not it was
+// created before. Identify already upserts, Upsert falls back to Update when
+// the API reports the subscriber as a conflict instead.
+func (s *SubscriberService) Upsert(ctx context.Context, subscriberID string, data interface{}) (SubscriberResponse, error) {
+	resp, err := s.Identify(ctx, subscriberID, data)
+
+	var apiErr *NovuAPIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusConflict {
+		return s.Update(ctx, subscriberID, data)
+	}
+
+	return resp, err
+}
+
 func (s *SubscriberService) BulkCreate(ctx context.Context, subscribers SubscriberBulkPayload) (SubscriberBulkCreateResponse, error) {
 	var resp SubscriberBulkCreateResponse
 	URL := s.client.config.BackendURL.JoinPath("subscribers", "bulk")
@@ -89,6 +142,48 @@ func (s *SubscriberService) Get(ctx context.Context, subscriberID string) (Subsc
 	return resp, nil
 }
 
+func (s *SubscriberService) List(ctx context.Context, opts *SubscriberListOptions) (*SubscriberListResponse, error) {
+	var resp SubscriberListResponse
+	URL := s.client.config.BackendURL.JoinPath("subscribers")
+
+	if opts != nil {
+		params, err := GenerateQueryParamsFromStruct(*opts)
+		if err != nil {
+			return nil, err
+		}
+
+		queryValues := URL.Query()
+		for _, param := range params {
+			queryValues.Add(param.Key, param.Value)
+		}
+		URL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, URL.String(), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.client.sendRequest(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// ListPage lists a page of subscribers. Pass NextCursor of the result as
+// opts.FetchNextPage to load the next page.
+func (s *SubscriberService) ListPage(ctx context.Context, opts PageOptions) (*PagedResult[Subscriber], error) {
+	return fetchPage[Subscriber](ctx, s.client, s.client.config.BackendURL.JoinPath("subscribers"), opts)
+}
+
+// SearchSubscribers lists the subscribers whose email, phone, name or
+// subscriberId match query.
+func (s *SubscriberService) SearchSubscribers(ctx context.Context, query string, page int, limit int) (*SubscriberListResponse, error) {
+	return s.List(ctx, &SubscriberListOptions{Page: page, Limit: limit, Query: query})
+}
+
 func (s *SubscriberService) Update(ctx context.Context, subscriberID string, data interface{}) (SubscriberResponse, error) {
 	var resp SubscriberResponse
 	URL := s.client.config.BackendURL.JoinPath("subscribers", subscriberID)
@@ -127,6 +222,94 @@ func (s *SubscriberService) UpdateCredentials(ctx context.Context, subscriberID
 	return resp, nil
 }
 
+// SetCredentials modifies the subscriber's credentials for a provider. Unlike
+// UpdateCredentials, device tokens are added to the ones already stored.
+func (s *SubscriberService) SetCredentials(ctx context.Context, subscriberID string, data SubscriberCredentialPayload) (SubscriberResponse, error) {
+	var resp SubscriberResponse
+	URL := s.client.config.BackendURL.JoinPath("subscribers", subscriberID, "credentials")
+
+	jsonBody, _ := json.Marshal(data)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, URL.String(), bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return resp, err
+	}
+
+	_, err = s.client.sendRequest(req, &resp)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+// GetCredentials returns the provider credentials stored on the subscriber.
+func (s *SubscriberService) GetCredentials(ctx context.Context, subscriberID string) ([]SubscriberChannelCredential, error) {
+	var resp struct {
+		Data struct {
+			Channels []SubscriberChannelCredential `json:"channels"`
+		} `json:"data"`
+	}
+	URL := s.client.config.BackendURL.JoinPath("subscribers", subscriberID)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, URL.String(), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.client.sendRequest(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Data.Channels, nil
+}
+
+// DeleteCredentials removes the subscriber's credentials for a provider, such
+// as a stale push token after the user signs out of a device.
+func (s *SubscriberService) DeleteCredentials(ctx context.Context, subscriberID string, providerId string) error {
+	var resp interface{}
+	URL := s.client.config.BackendURL.JoinPath("subscribers", subscriberID, "credentials", providerId)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, URL.String(), http.NoBody)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.sendRequest(req, &resp)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateOnlineStatus sets the subscriber's presence. lastOnlineAt is only sent
+// when it is not nil, formatted as RFC 3339.
+func (s *SubscriberService) UpdateOnlineStatus(ctx context.Context, subscriberID string, isOnline bool, lastOnlineAt *time.Time) (SubscriberResponse, error) {
+	var resp SubscriberResponse
+	URL := s.client.config.BackendURL.JoinPath("subscribers", subscriberID, "online-status")
+
+	reqBody := UpdateSubscriberOnlineStatusRequest{IsOnline: isOnline}
+	if lastOnlineAt != nil {
+		reqBody.LastOnlineAt = lastOnlineAt.Format(time.RFC3339)
+	}
+
+	jsonBody, _ := json.Marshal(reqBody)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, URL.String(), bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return resp, err
+	}
+
+	_, err = s.client.sendRequest(req, &resp)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
 func (s *SubscriberService) Delete(ctx context.Context, subscriberID string) (SubscriberResponse, error) {
 	var resp SubscriberResponse
 	URL := s.client.config.BackendURL.JoinPath("subscribers", subscriberID)
@@ -144,6 +327,265 @@ func (s *SubscriberService) Delete(ctx context.Context, subscriberID string) (Su
 	return resp, nil
 }
 
+// BulkDelete deletes up to MaxBulkDeleteSubscribers subscribers, sending a few
+// delete calls at a time as the API has no bulk delete route. Every subscriber
+// ends up in exactly one of Deleted, NotFound or Errors. A larger slice is
+// rejected with ErrBulkDeleteTooLarge before anything is deleted.
+func (s *SubscriberService) BulkDelete(ctx context.Context, subscriberIDs []string) (*BulkDeleteResult, error) {
+	if len(subscriberIDs) > MaxBulkDeleteSubscribers {
+		return nil, errors.Wrapf(ErrBulkDeleteTooLarge, "got %d subscribers", len(subscriberIDs))
+	}
+
+	result := BulkDeleteResult{Deleted: []string{}, NotFound: []string{}, Errors: []BulkDeleteError{}}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, bulkDeleteConcurrency)
+
+	for _, subscriberID := range subscriberIDs {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(subscriberID string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			_, err := s.Delete(ctx, subscriberID)
+
+			mu.Lock()
+			defer mu.Unlock()
+			var apiErr *NovuAPIError
+			switch {
+			case err == nil:
+				result.Deleted = append(result.Deleted, subscriberID)
+			case errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound:
+				result.NotFound = append(result.NotFound, subscriberID)
+			default:
+				result.Errors = append(result.Errors, BulkDeleteError{SubscriberID: subscriberID, Error: err.Error()})
+			}
+		}(subscriberID)
+	}
+	wg.Wait()
+
+	return &result, nil
+}
+
+// GetNotificationActivity lists the notifications sent to the subscriber on
+// every channel, for support tooling. Each step's delivery status is in
+// NotificationItem.Jobs, and ExecutionsApi.GetExecutions details it further.
+// Use GetNotificationFeed for the subscriber's in-app inbox.
+func (s *SubscriberService) GetNotificationActivity(ctx context.Context, subscriberID string, page int, limit int) (*NotificationListResponse, error) {
+	return (*NotificationService)(s).GetNotifications(ctx, GetNotificationsOptions{
+		SubscriberIds: []string{subscriberID},
+		Page:          page,
+		Limit:         limit,
+	})
+}
+
+// DeleteAllMessages permanently deletes every message sent to the subscriber,
+// e.g. to honor a GDPR erasure request. It cannot be undone. The subscriber
+// itself is kept; call Delete to remove it too. A missing subscriber fails with
+// ErrSubscriberNotFound instead of succeeding without deleting anything.
+func (s *SubscriberService) DeleteAllMessages(ctx context.Context, subscriberID string) error {
+	var apiErr *NovuAPIError
+	if _, err := s.Get(ctx, subscriberID); err != nil {
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			return errors.Wrapf(ErrSubscriberNotFound, "subscriber %s", subscriberID)
+		}
+		return err
+	}
+
+	messages := (*MessagesService)(s)
+	for {
+		// deleted messages drop out of the list, so the first page always holds the next ones
+		page, err := messages.GetMessages(ctx, MessagesQueryParams{SubscriberId: subscriberID, Limit: deleteMessagesPageSize})
+		if err != nil {
+			return err
+		}
+		if len(page.Data) == 0 {
+			return nil
+		}
+
+		deleted := 0
+		for _, message := range page.Data {
+			_, err := messages.DeleteMessage(ctx, message.Id)
+			switch {
+			case err == nil:
+				deleted++
+			case errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound:
+			default:
+				return errors.Wrapf(err, "failed to delete message %s", message.Id)
+			}
+		}
+		// a page of messages that are already gone means the list is stale, not that more are left
+		if deleted == 0 {
+			return nil
+		}
+	}
+}
+
+// MergeSubscribers folds the secondary subscriber, e.g. an anonymous session,
+// into the primary one after the user signs up. The API has no merge endpoint,
+// so the secondary's profile fields and data keys fill in the ones the primary
+// lacks, its push device tokens and missing channel credentials are added to
+// the primary, and the primary joins the secondary's topics. Notifications and
+// messages cannot be moved to another subscriber, so the secondary is kept with
+// its history.
+//
+// The steps are separate requests and are not rolled back: when one fails, the
+// steps before it stay applied and the error is returned with a result listing
+// them. Every step only adds to the primary, so the merge can be run again as a
+// whole. Delete the secondary, which also deletes its history, only once
+// Completed is set.
+func (s *SubscriberService) MergeSubscribers(ctx context.Context, primarySubscriberID string, secondarySubscriberID string) (*MergeSubscribersResult, error) {
+	result := &MergeSubscribersResult{}
+	if primarySubscriberID == secondarySubscriberID {
+		return result, errors.New("cannot merge a subscriber into itself")
+	}
+
+	primary, err := s.getMergeSubscriber(ctx, primarySubscriberID)
+	if err != nil {
+		return result, err
+	}
+	secondary, err := s.getMergeSubscriber(ctx, secondarySubscriberID)
+	if err != nil {
+		return result, err
+	}
+
+	if profile := mergeSubscriberProfile(primary.Subscriber, secondary.Subscriber); len(profile) > 0 {
+		if _, err := s.Update(ctx, primarySubscriberID, profile); err != nil {
+			return result, errors.Wrap(err, "failed to merge the subscriber profile")
+		}
+		result.ProfileMerged = true
+	}
+
+	for _, credential := range secondary.Channels {
+		if !mergeCredential(primary.Channels, credential) {
+			continue
+		}
+		var credentials Credentials
+		raw, _ := json.Marshal(credential.Credentials)
+		_ = json.Unmarshal(raw, &credentials)
+
+		_, err := s.SetCredentials(ctx, primarySubscriberID, SubscriberCredentialPayload{
+			ProviderId:            credential.ProviderId,
+			IntegrationIdentifier: credential.IntegrationIdentifier,
+			Credentials:           credentials,
+		})
+		if err != nil {
+			return result, errors.Wrapf(err, "failed to merge the %s credentials", credential.ProviderId)
+		}
+		result.CredentialsMerged = append(result.CredentialsMerged, credential.ProviderId)
+	}
+
+	topics := (*TopicService)(s)
+	for page, listed := 0, 0; ; page++ {
+		list, err := s.GetTopics(ctx, secondarySubscriberID, page, mergeTopicsPageSize)
+		if err != nil {
+			return result, err
+		}
+		for _, topic := range list.Data {
+			if _, err := topics.AddSubscribers(ctx, topic.Key, []string{primarySubscriberID}); err != nil {
+				return result, errors.Wrapf(err, "failed to add the subscriber to topic %s", topic.Key)
+			}
+			result.TopicsJoined = append(result.TopicsJoined, topic.Key)
+		}
+		listed += len(list.Data)
+		if len(list.Data) == 0 || listed >= list.TotalCount {
+			break
+		}
+	}
+	result.Completed = true
+
+	result.Subscriber, err = s.Get(ctx, primarySubscriberID)
+	if err != nil {
+		return result, errors.Wrap(err, "failed to get the merged subscriber")
+	}
+	return result, nil
+}
+
+type mergeSubscriber struct {
+	Subscriber
+	Channels []SubscriberChannelCredential `json:"channels"`
+}
+
+func (s *SubscriberService) getMergeSubscriber(ctx context.Context, subscriberID string) (mergeSubscriber, error) {
+	var resp struct {
+		Data mergeSubscriber `json:"data"`
+	}
+	URL := s.client.config.BackendURL.JoinPath("subscribers", subscriberID)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, URL.String(), http.NoBody)
+	if err != nil {
+		return resp.Data, err
+	}
+
+	_, err = s.client.sendRequest(req, &resp)
+	if err != nil {
+		var apiErr *NovuAPIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			return resp.Data, errors.Wrapf(ErrSubscriberNotFound, "subscriber %s", subscriberID)
+		}
+		return resp.Data, err
+	}
+
+	return resp.Data, nil
+}
+
+// mergeSubscriberProfile returns the update that fills in the fields and data
+// keys of primary that are empty, from secondary. Set values of primary win.
+func mergeSubscriberProfile(primary Subscriber, secondary Subscriber) map[string]interface{} {
+	update := map[string]interface{}{}
+	fields := []struct {
+		name     string
+		primary  string
+		fallback string
+	}{
+		{"firstName", primary.FirstName, secondary.FirstName},
+		{"lastName", primary.LastName, secondary.LastName},
+		{"email", primary.Email, secondary.Email},
+		{"phone", primary.Phone, secondary.Phone},
+		{"avatar", primary.Avatar, secondary.Avatar},
+		{"locale", primary.Locale, secondary.Locale},
+	}
+	for _, field := range fields {
+		if field.primary == "" && field.fallback != "" {
+			update[field.name] = field.fallback
+		}
+	}
+
+	data := map[string]interface{}{}
+	for key, value := range primary.Data {
+		data[key] = value
+	}
+	added := false
+	for key, value := range secondary.Data {
+		if _, ok := data[key]; !ok {
+			data[key] = value
+			added = true
+		}
+	}
+	if added {
+		update["data"] = data
+	}
+
+	return update
+}
+
+// mergeCredential reports whether the secondary's credential should be copied
+// to the primary. Device tokens are always added, since SetCredentials keeps the
+// primary's own, but other credentials such as a chat webhook URL are only
+// copied when the primary has none for that integration.
+func mergeCredential(primary []SubscriberChannelCredential, credential SubscriberChannelCredential) bool {
+	if tokens, ok := credential.Credentials["deviceTokens"].([]interface{}); ok && len(tokens) > 0 {
+		return true
+	}
+	for _, existing := range primary {
+		if existing.ProviderId == credential.ProviderId && existing.IntegrationIdentifier == credential.IntegrationIdentifier {
+			return false
+		}
+	}
+	return len(credential.Credentials) > 0
+}
+
 func (s *SubscriberService) GetNotificationFeed(ctx context.Context, subscriberID string, opts *SubscriberNotificationFeedOptions) (*SubscriberNotificationFeedResponse, error) {
 	var resp SubscriberNotificationFeedResponse
 	URL := s.client.config.BackendURL.JoinPath("subscribers", subscriberID, "notifications", "feed")
@@ -209,16 +651,42 @@ func (s *SubscriberService) GetPreferences(ctx context.Context, subscriberID str
 	return &resp, nil
 }
 
+// GetTopics lists the topics the subscriber belongs to.
+func (s *SubscriberService) GetTopics(ctx context.Context, subscriberID string, page int, limit int) (*ListTopicsResponse, error) {
+	var resp ListTopicsResponse
+	URL := s.client.config.BackendURL.JoinPath("subscribers", subscriberID, "topics")
+
+	v := URL.Query()
+	v.Set("page", strconv.Itoa(page))
+	v.Set("limit", strconv.Itoa(limit))
+	URL.RawQuery = v.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, URL.String(), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.client.sendRequest(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
 func (s *SubscriberService) GetUnseenCount(ctx context.Context, subscriberID string, opts *SubscriberUnseenCountOptions) (*SubscriberUnseenCountResponse, error) {
 	var resp SubscriberUnseenCountResponse
 	URL := s.client.config.BackendURL.JoinPath("subscribers", subscriberID, "notifications", "unseen")
 
 	if opts != nil {
+		queryValues := URL.Query()
 		if opts.Seen != nil {
-			queryValues := URL.Query()
 			queryValues.Add("seen", strconv.FormatBool(*opts.Seen))
-			URL.RawQuery = queryValues.Encode()
 		}
+		if opts.FeedIdentifier != "" {
+			queryValues.Add("feedIdentifier", opts.FeedIdentifier)
+		}
+		URL.RawQuery = queryValues.Encode()
 	}
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, URL.String(), http.NoBody)
@@ -234,8 +702,38 @@ func (s *SubscriberService) GetUnseenCount(ctx context.Context, subscriberID str
 	return &resp, nil
 }
 
-func (s *SubscriberService) UpdatePreferences(ctx context.Context, subscriberID string, templateId string, opts *UpdateSubscriberPreferencesOptions) (*SubscriberPreferencesResponse, error) {
-	var resp SubscriberPreferencesResponse
+func (s *SubscriberService) GetUnreadCount(ctx context.Context, subscriberID string, opts *SubscriberUnreadCountOptions) (*SubscriberUnreadCountResponse, error) {
+	var resp SubscriberUnreadCountResponse
+	URL := s.client.config.BackendURL.JoinPath("subscribers", subscriberID, "notifications", "unread")
+
+	if opts != nil {
+		queryValues := URL.Query()
+		if opts.Read != nil {
+			queryValues.Add("read", strconv.FormatBool(*opts.Read))
+		}
+		if opts.FeedIdentifier != "" {
+			queryValues.Add("feedIdentifier", opts.FeedIdentifier)
+		}
+		URL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, URL.String(), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.client.sendRequest(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// UpdatePreferences updates the subscriber's preference for a single workflow
+// and returns the resulting preference.
+func (s *SubscriberService) UpdatePreferences(ctx context.Context, subscriberID string, templateId string, opts *UpdateSubscriberPreferencesOptions) (*SubscriberPreferenceResponse, error) {
+	var resp SubscriberPreferenceResponse
 	URL := s.client.config.BackendURL.JoinPath("subscribers", subscriberID, "preferences", templateId)
 
 	var reqBody io.Reader = http.NoBody
@@ -284,4 +782,193 @@ func (s *SubscriberService) MarkMessageSeen(ctx context.Context, subscriberID st
 	return &resp, nil
 }
 
+func (s *SubscriberService) MarkMessagesSeen(ctx context.Context, subscriberID string, messageIDs []string) (*SubscriberNotificationFeedResponse, error) {
+	return s.markMessagesAs(ctx, subscriberID, messageIDs, MessageMarkAsSeen)
+}
+
+func (s *SubscriberService) MarkMessagesRead(ctx context.Context, subscriberID string, messageIDs []string) (*SubscriberNotificationFeedResponse, error) {
+	return s.markMessagesAs(ctx, subscriberID, messageIDs, MessageMarkAsRead)
+}
+
+func (s *SubscriberService) markMessagesAs(ctx context.Context, subscriberID string, messageIDs []string, markAs MessageMarkAs) (*SubscriberNotificationFeedResponse, error) {
+	var resp SubscriberNotificationFeedResponse
+	URL := s.client.config.BackendURL.JoinPath("subscribers", subscriberID, "messages", "mark-as")
+
+	jsonBody, err := json.Marshal(MarkMessagesAsRequest{MessageIds: messageIDs, MarkAs: markAs})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, URL.String(), bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.client.sendRequest(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// MarkAllMessagesRead marks every message in the feed as read, or as unread
+// when read is false. An empty feedIdentifier targets all feeds.
+func (s *SubscriberService) MarkAllMessagesRead(ctx context.Context, subscriberID string, feedIdentifier string, read bool) (*MarkAllMessagesResponse, error) {
+	var resp MarkAllMessagesResponse
+	URL := s.client.config.BackendURL.JoinPath("subscribers", subscriberID, "messages", "mark-all")
+
+	markAs := MessageMarkAsRead
+	if !read {
+		markAs = MessageMarkAsUnread
+	}
+
+	jsonBody, err := json.Marshal(MarkAllMessagesRequest{MarkAs: markAs, FeedIdentifier: feedIdentifier})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, URL.String(), bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.client.sendRequest(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+func (s *SubscriberService) Iterate(limit int) *Paginator[Subscriber] {
+	return NewPaginator(func(ctx context.Context, page int) ([]Subscriber, int, error) {
+		resp, err := s.List(ctx, &SubscriberListOptions{Page: page, Limit: limit})
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Data, resp.TotalCount, nil
+	})
+}
+
+// GetGlobalPreferences returns the subscriber's preferences applying to every
+// workflow. The entries have no template.
+func (s *SubscriberService) GetGlobalPreferences(ctx context.Context, subscriberID string) (*SubscriberPreferencesResponse, error) {
+	var resp SubscriberPreferencesResponse
+	URL := s.client.config.BackendURL.JoinPath("subscribers", subscriberID, "preferences", "global")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, URL.String(), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.client.sendRequest(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// UpdateGlobalPreferences updates the subscriber's preferences for every
+// workflow in one call, e.g. to turn off a channel everywhere. Preferences set
+// for a single workflow with UpdatePreferences still take precedence.
+func (s *SubscriberService) UpdateGlobalPreferences(ctx context.Context, subscriberID string, opts UpdateSubscriberGlobalPreferencesOptions) (*SubscriberPreferenceResponse, error) {
+	var resp SubscriberPreferenceResponse
+	URL := s.client.config.BackendURL.JoinPath("subscribers", subscriberID, "preferences")
+
+	jsonBody, _ := json.Marshal(opts)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, URL.String(), bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.client.sendRequest(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// UpdateAllSubscriberPreferences applies several preference updates of the
+// subscriber at once, e.g. to turn off every marketing workflow. The API takes
+// one workflow per request, so the updates are sent with up to 5 concurrent
+// requests; entries without a TemplateId go to the global preferences. The
+// updated preferences are returned in the order of preferences. The first
+// failure cancels the updates not sent yet and is returned, while the updates
+// that already succeeded stay applied, so retrying the whole list is safe.
+func (s *SubscriberService) UpdateAllSubscriberPreferences(ctx context.Context, subscriberID string, preferences []UpdatePreferenceRequest) ([]SubscriberPreference, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	updated := make([]SubscriberPreference, len(preferences))
+	var firstErr error
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, preferenceUpdateConcurrency)
+
+	for i, preference := range preferences {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, preference UpdatePreferenceRequest) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			resp, err := s.updatePreference(ctx, subscriberID, preference)
+			if err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = errors.Wrapf(err, "failed to update the preference of workflow %q", preference.TemplateId)
+					cancel()
+				}
+				mu.Unlock()
+				return
+			}
+			updated[i] = resp.Data
+		}(i, preference)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return updated, nil
+}
+
+func (s *SubscriberService) updatePreference(ctx context.Context, subscriberID string, preference UpdatePreferenceRequest) (*SubscriberPreferenceResponse, error) {
+	if preference.TemplateId == "" {
+		return s.UpdateGlobalPreferences(ctx, subscriberID, UpdateSubscriberGlobalPreferencesOptions{
+			Enabled:     preference.Enabled,
+			Preferences: preference.Channels,
+		})
+	}
+
+	var resp SubscriberPreferenceResponse
+	URL := s.client.config.BackendURL.JoinPath("subscribers", subscriberID, "preferences", preference.TemplateId)
+
+	jsonBody, _ := json.Marshal(preference)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, URL.String(), bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.client.sendRequest(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// UpdateGlobalChannelPreference turns a channel on or off for every workflow,
+// e.g. for an "unsubscribe from all emails" link.
+func (s *SubscriberService) UpdateGlobalChannelPreference(ctx context.Context, subscriberID string, channel ChannelType, enabled bool) (*SubscriberPreferenceResponse, error) {
+	return s.UpdateGlobalPreferences(ctx, subscriberID, UpdateSubscriberGlobalPreferencesOptions{
+		Preferences: []UpdateSubscriberPreferencesChannel{{Type: channel, Enabled: enabled}},
+	})
+}
+
 var _ ISubscribers = &SubscriberService{}
@@ -12,6 +12,7 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/novuhq/go-novu/lib"
 	"github.com/stretchr/testify/assert"
@@ -287,7 +288,9 @@ func TestSubscriberService_GetNotificationFeed_Success(t *testing.T) {
 	fileToStruct(filepath.Join("../testdata", "subscriber_notification_feed_response.json"), &expectedResponse)
 
 	page := 1
+	limit := 20
 	seen := true
+	read := false
 	feedIdentifier := "feed_identifier"
 	payload := map[string]interface{}{
 		"name": "test",
@@ -295,13 +298,15 @@ func TestSubscriberService_GetNotificationFeed_Success(t *testing.T) {
 
 	opts := lib.SubscriberNotificationFeedOptions{
 		Page:           page,
-		Seen:           seen,
+		Limit:          limit,
+		Seen:           &seen,
+		Read:           &read,
 		FeedIdentifier: feedIdentifier,
 		Payload:        payload,
 	}
 
 	httpServer := createTestServer(t, TestServerOptions[io.Reader, *lib.SubscriberNotificationFeedResponse]{
-		expectedURLPath:    fmt.Sprintf("/v1/subscribers/%s/notifications/feed?feedIdentifier=%s&page=%s&payload=eyJuYW1lIjoidGVzdCJ9&seen=%s", subscriberID, feedIdentifier, strconv.Itoa(page), strconv.FormatBool(seen)),
+		expectedURLPath:    fmt.Sprintf("/v1/subscribers/%s/notifications/feed?feedIdentifier=%s&limit=%s&page=%s&payload=eyJuYW1lIjoidGVzdCJ9&read=%s&seen=%s", subscriberID, feedIdentifier, strconv.Itoa(limit), strconv.Itoa(page), strconv.FormatBool(read), strconv.FormatBool(seen)),
 		expectedSentMethod: http.MethodGet,
 		expectedSentBody:   http.NoBody,
 		responseStatusCode: http.StatusOK,
@@ -410,8 +415,9 @@ func TestSubscriberService_MarkMessageSeen(t *testing.T) {
 func TestSubscriberService_UpdatePreferences_Success(t *testing.T) {
 	var topicID = "topicId"
 
-	var expectedResponse *lib.SubscriberPreferencesResponse
-	fileToStruct(filepath.Join("../testdata", "subscriber_preferences_response.json"), &expectedResponse)
+	var preferences *lib.SubscriberPreferencesResponse
+	fileToStruct(filepath.Join("../testdata", "subscriber_preferences_response.json"), &preferences)
+	expectedResponse := &lib.SubscriberPreferenceResponse{Data: preferences.Data[0]}
 
 	var opts *lib.UpdateSubscriberPreferencesOptions = &lib.UpdateSubscriberPreferencesOptions{
 		Enabled: true,
@@ -422,7 +428,7 @@ func TestSubscriberService_UpdatePreferences_Success(t *testing.T) {
 			},
 		},
 	}
-	httpServer := createTestServer(t, TestServerOptions[*lib.UpdateSubscriberPreferencesOptions, *lib.SubscriberPreferencesResponse]{
+	httpServer := createTestServer(t, TestServerOptions[*lib.UpdateSubscriberPreferencesOptions, *lib.SubscriberPreferenceResponse]{
 		expectedURLPath:    fmt.Sprintf("/v1/subscribers/%s/preferences/%s", subscriberID, topicID),
 		expectedSentMethod: http.MethodPatch,
 		expectedSentBody:   opts,
@@ -437,3 +443,657 @@ func TestSubscriberService_UpdatePreferences_Success(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, resp, expectedResponse)
 }
+
+func TestSubscriberService_UpdateGlobalPreferences_Success(t *testing.T) {
+	expectedResponse := &lib.SubscriberPreferenceResponse{
+		Data: lib.SubscriberPreference{Preference: lib.Preference{Enabled: true, Channels: lib.Channel{Email: false, Sms: true}}},
+	}
+
+	httpServer := createTestServer(t, TestServerOptions[map[string]interface{}, *lib.SubscriberPreferenceResponse]{
+		expectedURLPath:    fmt.Sprintf("/v1/subscribers/%s/preferences", subscriberID),
+		expectedSentMethod: http.MethodPatch,
+		expectedSentBody: map[string]interface{}{
+			"preferences": []interface{}{map[string]interface{}{"type": "email", "enabled": false}},
+		},
+		responseStatusCode: http.StatusOK,
+		responseBody:       expectedResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.SubscriberApi.UpdateGlobalPreferences(ctx, subscriberID, lib.UpdateSubscriberGlobalPreferencesOptions{
+		Preferences: []lib.UpdateSubscriberPreferencesChannel{{Type: lib.ChannelTypeEmail, Enabled: false}},
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, expectedResponse, resp)
+}
+
+func TestSubscriberService_GetGlobalPreferences_Success(t *testing.T) {
+	expectedResponse := &lib.SubscriberPreferencesResponse{
+		Data: []lib.SubscriberPreference{{Preference: lib.Preference{Enabled: true, Channels: lib.Channel{Email: true, Sms: false}}}},
+	}
+
+	httpServer := createTestServer(t, TestServerOptions[io.Reader, *lib.SubscriberPreferencesResponse]{
+		expectedURLPath:    fmt.Sprintf("/v1/subscribers/%s/preferences/global", subscriberID),
+		expectedSentMethod: http.MethodGet,
+		expectedSentBody:   http.NoBody,
+		responseStatusCode: http.StatusOK,
+		responseBody:       expectedResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.SubscriberApi.GetGlobalPreferences(ctx, subscriberID)
+
+	require.NoError(t, err)
+	require.Equal(t, expectedResponse, resp)
+}
+
+func TestSubscriberService_UpdateGlobalChannelPreference_Success(t *testing.T) {
+	expectedResponse := &lib.SubscriberPreferenceResponse{
+		Data: lib.SubscriberPreference{Preference: lib.Preference{Enabled: true, Channels: lib.Channel{Email: false}}},
+	}
+
+	httpServer := createTestServer(t, TestServerOptions[map[string]interface{}, *lib.SubscriberPreferenceResponse]{
+		expectedURLPath:    fmt.Sprintf("/v1/subscribers/%s/preferences", subscriberID),
+		expectedSentMethod: http.MethodPatch,
+		expectedSentBody: map[string]interface{}{
+			"preferences": []interface{}{map[string]interface{}{"type": "email", "enabled": false}},
+		},
+		responseStatusCode: http.StatusOK,
+		responseBody:       expectedResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.SubscriberApi.UpdateGlobalChannelPreference(ctx, subscriberID, lib.ChannelTypeEmail, false)
+
+	require.NoError(t, err)
+	require.Equal(t, expectedResponse, resp)
+}
+
+func TestSubscriberService_UpdateAllSubscriberPreferences(t *testing.T) {
+	disabled := false
+	httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		require.Equal(t, http.MethodPatch, req.Method)
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+
+		templateID := strings.TrimPrefix(req.URL.Path, fmt.Sprintf("/v1/subscribers/%s/preferences", subscriberID))
+		switch templateID {
+		case "/marketing", "/newsletter":
+			require.Equal(t, map[string]interface{}{"enabled": false}, body)
+		case "":
+			require.Equal(t, map[string]interface{}{"preferences": []interface{}{map[string]interface{}{"type": "sms", "enabled": false}}}, body)
+		case "/missing":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"statusCode":404,"message":"Workflow not found"}`))
+			return
+		default:
+			t.Errorf("unexpected request %s", req.URL.Path)
+		}
+		json.NewEncoder(w).Encode(lib.SubscriberPreferenceResponse{Data: lib.SubscriberPreference{
+			Template: lib.Template{ID: strings.TrimPrefix(templateID, "/")},
+		}})
+	}))
+	defer httpServer.Close()
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.SubscriberApi.UpdateAllSubscriberPreferences(ctx, subscriberID, []lib.UpdatePreferenceRequest{
+		{TemplateId: "marketing", Enabled: &disabled},
+		{TemplateId: "newsletter", Enabled: &disabled},
+		{Channels: []lib.UpdateSubscriberPreferencesChannel{{Type: lib.ChannelTypeSMS, Enabled: false}}},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp, 3)
+	require.Equal(t, "marketing", resp[0].Template.ID)
+	require.Equal(t, "newsletter", resp[1].Template.ID)
+	require.Empty(t, resp[2].Template.ID)
+
+	_, err = c.SubscriberApi.UpdateAllSubscriberPreferences(ctx, subscriberID, []lib.UpdatePreferenceRequest{
+		{TemplateId: "marketing", Enabled: &disabled},
+		{TemplateId: "missing", Enabled: &disabled},
+	})
+	require.ErrorContains(t, err, `failed to update the preference of workflow "missing"`)
+}
+
+func TestSubscriberService_List_Success(t *testing.T) {
+	expectedResponse := lib.SubscriberListResponse{
+		Page:       1,
+		PageSize:   10,
+		TotalCount: 1,
+		Data: []lib.Subscriber{{
+			Id:           "63dafed97779f59258e38445",
+			SubscriberId: subscriberID,
+			FirstName:    "John",
+			LastName:     "Doe",
+			Email:        "john@doemail.com",
+		}},
+	}
+
+	httpServer := createTestServer(t, TestServerOptions[io.Reader, lib.SubscriberListResponse]{
+		expectedURLPath:    "/v1/subscribers?limit=10&page=1",
+		expectedSentMethod: http.MethodGet,
+		expectedSentBody:   http.NoBody,
+		responseStatusCode: http.StatusOK,
+		responseBody:       expectedResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.SubscriberApi.List(ctx, &lib.SubscriberListOptions{Page: 1, Limit: 10})
+
+	require.NoError(t, err)
+	require.Equal(t, &expectedResponse, resp)
+}
+
+func TestSubscriberService_SearchSubscribers_Success(t *testing.T) {
+	expectedResponse := lib.SubscriberListResponse{
+		Page:       0,
+		PageSize:   10,
+		TotalCount: 1,
+		Data: []lib.Subscriber{{
+			SubscriberId: subscriberID,
+			Email:        "john@doemail.com",
+		}},
+	}
+
+	httpServer := createTestServer(t, TestServerOptions[io.Reader, lib.SubscriberListResponse]{
+		expectedURLPath:    "/v1/subscribers?limit=10&query=john%40doemail.com",
+		expectedSentMethod: http.MethodGet,
+		expectedSentBody:   http.NoBody,
+		responseStatusCode: http.StatusOK,
+		responseBody:       expectedResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.SubscriberApi.SearchSubscribers(ctx, "john@doemail.com", 0, 10)
+
+	require.NoError(t, err)
+	require.Equal(t, &expectedResponse, resp)
+}
+
+func TestSubscriberService_GetTopics_Success(t *testing.T) {
+	expectedResponse := lib.ListTopicsResponse{
+		Page:       0,
+		PageSize:   10,
+		TotalCount: 1,
+		Data:       []lib.GetTopicResponse{{Key: "topicKey", Name: "Topic", Subscribers: []string{subscriberID}}},
+	}
+
+	httpServer := createTestServer(t, TestServerOptions[io.Reader, lib.ListTopicsResponse]{
+		expectedURLPath:    fmt.Sprintf("/v1/subscribers/%s/topics?limit=10&page=0", subscriberID),
+		expectedSentMethod: http.MethodGet,
+		expectedSentBody:   http.NoBody,
+		responseStatusCode: http.StatusOK,
+		responseBody:       expectedResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.SubscriberApi.GetTopics(ctx, subscriberID, 0, 10)
+
+	require.NoError(t, err)
+	require.Equal(t, &expectedResponse, resp)
+}
+
+func TestSubscriberService_MarkMessagesSeen(t *testing.T) {
+	var expectedResponse *lib.SubscriberNotificationFeedResponse
+	fileToStruct(filepath.Join("../testdata", "subscriber_notification_feed_response.json"), &expectedResponse)
+
+	httpServer := createTestServer(t, TestServerOptions[lib.MarkMessagesAsRequest, *lib.SubscriberNotificationFeedResponse]{
+		expectedURLPath:    fmt.Sprintf("/v1/subscribers/%s/messages/mark-as", subscriberID),
+		expectedSentMethod: http.MethodPost,
+		expectedSentBody:   lib.MarkMessagesAsRequest{MessageIds: []string{"first", "second"}, MarkAs: lib.MessageMarkAsSeen},
+		responseStatusCode: http.StatusOK,
+		responseBody:       expectedResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.SubscriberApi.MarkMessagesSeen(ctx, subscriberID, []string{"first", "second"})
+
+	require.NoError(t, err)
+	require.Equal(t, expectedResponse, resp)
+}
+
+func TestSubscriberService_MarkMessagesRead(t *testing.T) {
+	var expectedResponse *lib.SubscriberNotificationFeedResponse
+	fileToStruct(filepath.Join("../testdata", "subscriber_notification_feed_response.json"), &expectedResponse)
+
+	httpServer := createTestServer(t, TestServerOptions[lib.MarkMessagesAsRequest, *lib.SubscriberNotificationFeedResponse]{
+		expectedURLPath:    fmt.Sprintf("/v1/subscribers/%s/messages/mark-as", subscriberID),
+		expectedSentMethod: http.MethodPost,
+		expectedSentBody:   lib.MarkMessagesAsRequest{MessageIds: []string{"first"}, MarkAs: lib.MessageMarkAsRead},
+		responseStatusCode: http.StatusOK,
+		responseBody:       expectedResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.SubscriberApi.MarkMessagesRead(ctx, subscriberID, []string{"first"})
+
+	require.NoError(t, err)
+	require.Equal(t, expectedResponse, resp)
+}
+
+func TestSubscriberService_MarkAllMessagesRead(t *testing.T) {
+	expectedResponse := &lib.MarkAllMessagesResponse{Data: 3}
+
+	httpServer := createTestServer(t, TestServerOptions[lib.MarkAllMessagesRequest, *lib.MarkAllMessagesResponse]{
+		expectedURLPath:    fmt.Sprintf("/v1/subscribers/%s/messages/mark-all", subscriberID),
+		expectedSentMethod: http.MethodPost,
+		expectedSentBody:   lib.MarkAllMessagesRequest{MarkAs: lib.MessageMarkAsUnread, FeedIdentifier: "feed_identifier"},
+		responseStatusCode: http.StatusCreated,
+		responseBody:       expectedResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.SubscriberApi.MarkAllMessagesRead(ctx, subscriberID, "feed_identifier", false)
+
+	require.NoError(t, err)
+	require.Equal(t, expectedResponse, resp)
+}
+
+func TestSubscriberService_UpdateOnlineStatus(t *testing.T) {
+	var expectedResponse lib.SubscriberResponse
+	fileToStruct(filepath.Join("../testdata", "subscriber_response.json"), &expectedResponse)
+
+	lastOnlineAt := time.Date(2023, 10, 1, 12, 30, 0, 0, time.UTC)
+
+	t.Run("with last online date", func(t *testing.T) {
+		httpServer := createTestServer(t, TestServerOptions[lib.UpdateSubscriberOnlineStatusRequest, lib.SubscriberResponse]{
+			expectedURLPath:    fmt.Sprintf("/v1/subscribers/%s/online-status", subscriberID),
+			expectedSentMethod: http.MethodPatch,
+			expectedSentBody:   lib.UpdateSubscriberOnlineStatusRequest{IsOnline: false, LastOnlineAt: "2023-10-01T12:30:00Z"},
+			responseStatusCode: http.StatusOK,
+			responseBody:       expectedResponse,
+		})
+
+		c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+		resp, err := c.SubscriberApi.UpdateOnlineStatus(context.Background(), subscriberID, false, &lastOnlineAt)
+
+		require.NoError(t, err)
+		require.Equal(t, expectedResponse, resp)
+	})
+
+	t.Run("without last online date", func(t *testing.T) {
+		httpServer := createTestServer(t, TestServerOptions[map[string]interface{}, lib.SubscriberResponse]{
+			expectedURLPath:    fmt.Sprintf("/v1/subscribers/%s/online-status", subscriberID),
+			expectedSentMethod: http.MethodPatch,
+			expectedSentBody:   map[string]interface{}{"isOnline": true},
+			responseStatusCode: http.StatusOK,
+			responseBody:       expectedResponse,
+		})
+
+		c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+		resp, err := c.SubscriberApi.UpdateOnlineStatus(context.Background(), subscriberID, true, nil)
+
+		require.NoError(t, err)
+		require.Equal(t, expectedResponse, resp)
+	})
+}
+
+func TestSubscriberService_SetCredentials(t *testing.T) {
+	var expectedResponse lib.SubscriberResponse
+	fileToStruct(filepath.Join("../testdata", "subscriber_response.json"), &expectedResponse)
+
+	payload := lib.SubscriberCredentialPayload{
+		Credentials:           lib.Credentials{DeviceTokens: []string{"device-token"}},
+		IntegrationIdentifier: "fcm-main",
+		ProviderId:            "fcm",
+	}
+	httpServer := createTestServer(t, TestServerOptions[lib.SubscriberCredentialPayload, lib.SubscriberResponse]{
+		expectedURLPath:    fmt.Sprintf("/v1/subscribers/%s/credentials", subscriberID),
+		expectedSentMethod: http.MethodPatch,
+		expectedSentBody:   payload,
+		responseStatusCode: http.StatusOK,
+		responseBody:       expectedResponse,
+	})
+
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.SubscriberApi.SetCredentials(context.Background(), subscriberID, payload)
+
+	require.NoError(t, err)
+	assert.Equal(t, expectedResponse, resp)
+}
+
+func TestSubscriberService_GetNotificationActivity(t *testing.T) {
+	expectedResponse := lib.NotificationListResponse{
+		Page:     1,
+		PageSize: 20,
+		Data: []lib.NotificationItem{{
+			Id:         "notificationId",
+			Channels:   []lib.StepType{lib.StepTypeEmail, lib.StepTypeSMS},
+			Template:   &lib.NotificationTemplate{Id: "templateId", Name: "Welcome"},
+			Subscriber: &lib.NotificationSubscriber{SubscriberId: subscriberID},
+			Jobs: []lib.NotificationJob{
+				{Id: "emailJobId", Type: lib.StepTypeEmail, Status: "completed"},
+				{Id: "smsJobId", Type: lib.StepTypeSMS, Status: "failed"},
+			},
+		}},
+	}
+
+	httpServer := createTestServer(t, TestServerOptions[io.Reader, lib.NotificationListResponse]{
+		expectedURLPath:    fmt.Sprintf("/v1/notifications?limit=20&page=1&subscriberIds=%s", subscriberID),
+		expectedSentMethod: http.MethodGet,
+		expectedSentBody:   http.NoBody,
+		responseStatusCode: http.StatusOK,
+		responseBody:       expectedResponse,
+	})
+
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.SubscriberApi.GetNotificationActivity(context.Background(), subscriberID, 1, 20)
+
+	require.NoError(t, err)
+	assert.Equal(t, &expectedResponse, resp)
+}
+
+func TestSubscriberService_BulkDelete(t *testing.T) {
+	httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, http.MethodDelete, req.Method)
+		switch strings.TrimPrefix(req.RequestURI, "/v1/subscribers/") {
+		case "missing":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"statusCode":404,"message":"Subscriber not found"}`))
+		case "broken":
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"statusCode":500,"message":"Internal server error"}`))
+		default:
+			w.Write([]byte(`{"data":{"acknowledged":true,"status":"deleted"}}`))
+		}
+	}))
+	defer httpServer.Close()
+
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.SubscriberApi.BulkDelete(context.Background(), []string{"first", "missing", "broken", "second"})
+
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"first", "second"}, resp.Deleted)
+	assert.Equal(t, []string{"missing"}, resp.NotFound)
+	require.Len(t, resp.Errors, 1)
+	assert.Equal(t, "broken", resp.Errors[0].SubscriberID)
+	assert.Contains(t, resp.Errors[0].Error, "status code 500")
+}
+
+func TestSubscriberService_BulkDelete_TooLarge(t *testing.T) {
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL("http://127.0.0.1:0")})
+	resp, err := c.SubscriberApi.BulkDelete(context.Background(), make([]string, lib.MaxBulkDeleteSubscribers+1))
+
+	require.ErrorIs(t, err, lib.ErrBulkDeleteTooLarge)
+	assert.Nil(t, resp)
+}
+
+func TestSubscriberService_DeleteAllMessages(t *testing.T) {
+	remaining := []string{"m1", "m2", "m3"}
+	var deleted []string
+	httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/subscribers/"+subscriberID:
+			w.Write([]byte(`{"data":{"subscriberId":"` + subscriberID + `"}}`))
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/messages":
+			assert.Equal(t, subscriberID, req.URL.Query().Get("subscriberId"))
+			page := lib.MessageListResponse{Data: []lib.Message{}}
+			for _, id := range remaining {
+				page.Data = append(page.Data, lib.Message{Id: id})
+			}
+			json.NewEncoder(w).Encode(page)
+		case req.Method == http.MethodDelete:
+			id := strings.TrimPrefix(req.URL.Path, "/v1/messages/")
+			deleted = append(deleted, id)
+			remaining = remaining[1:]
+			w.Write([]byte(`{"data":{"acknowledged":true,"status":"deleted"}}`))
+		default:
+			t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+	}))
+	defer httpServer.Close()
+
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	err := c.SubscriberApi.DeleteAllMessages(context.Background(), subscriberID)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"m1", "m2", "m3"}, deleted)
+	assert.Empty(t, remaining)
+}
+
+func TestSubscriberService_DeleteAllMessages_NotFound(t *testing.T) {
+	httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, http.MethodGet, req.Method)
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"statusCode":404,"message":"Subscriber not found"}`))
+	}))
+	defer httpServer.Close()
+
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	err := c.SubscriberApi.DeleteAllMessages(context.Background(), "missing")
+
+	require.ErrorIs(t, err, lib.ErrSubscriberNotFound)
+}
+
+func TestSubscriberService_MergeSubscribers(t *testing.T) {
+	var requests []string
+	httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+		var body map[string]interface{}
+		json.NewDecoder(req.Body).Decode(&body)
+
+		switch req.Method + " " + req.URL.Path {
+		case "GET /v1/subscribers/user-1":
+			w.Write([]byte(`{"data":{"subscriberId":"user-1","firstName":"Jane","data":{"plan":"pro"},
+				"channels":[{"providerId":"slack","credentials":{"webhookUrl":"https://hooks.slack.com/user"}}]}}`))
+		case "GET /v1/subscribers/anon-1":
+			w.Write([]byte(`{"data":{"subscriberId":"anon-1","firstName":"Anonymous","email":"jane@example.com","locale":"en",
+				"data":{"plan":"free","referrer":"ads"},
+				"channels":[
+					{"providerId":"fcm","credentials":{"deviceTokens":["token"]}},
+					{"providerId":"slack","credentials":{"webhookUrl":"https://hooks.slack.com/anonymous"}}
+				]}}`))
+		case "PUT /v1/subscribers/user-1":
+			assert.Equal(t, map[string]interface{}{
+				"email":  "jane@example.com",
+				"locale": "en",
+				"data":   map[string]interface{}{"plan": "pro", "referrer": "ads"},
+			}, body)
+			w.Write([]byte(`{"data":{}}`))
+		case "PATCH /v1/subscribers/user-1/credentials":
+			assert.Equal(t, map[string]interface{}{
+				"providerId":  "fcm",
+				"credentials": map[string]interface{}{"deviceTokens": []interface{}{"token"}},
+			}, body)
+			w.Write([]byte(`{"data":{}}`))
+		case "GET /v1/subscribers/anon-1/topics":
+			w.Write([]byte(`{"page":0,"pageSize":100,"totalCount":1,"data":[{"key":"news"}]}`))
+		case "POST /v1/topics/news/subscribers":
+			assert.Equal(t, map[string]interface{}{"subscribers": []interface{}{"user-1"}}, body)
+			w.Write([]byte(`{"data":{"succeeded":["user-1"]}}`))
+		default:
+			t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+	}))
+	defer httpServer.Close()
+
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	result, err := c.SubscriberApi.MergeSubscribers(context.Background(), "user-1", "anon-1")
+
+	require.NoError(t, err)
+	assert.True(t, result.ProfileMerged)
+	assert.Equal(t, []lib.ProviderIdType{lib.ProviderIDFCM}, result.CredentialsMerged)
+	assert.Equal(t, []string{"news"}, result.TopicsJoined)
+	assert.True(t, result.Completed)
+	assert.Equal(t, []string{
+		"GET /v1/subscribers/user-1",
+		"GET /v1/subscribers/anon-1",
+		"PUT /v1/subscribers/user-1",
+		"PATCH /v1/subscribers/user-1/credentials",
+		"GET /v1/subscribers/anon-1/topics",
+		"POST /v1/topics/news/subscribers",
+		"GET /v1/subscribers/user-1",
+	}, requests)
+}
+
+func TestSubscriberService_MergeSubscribers_NotFound(t *testing.T) {
+	httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/v1/subscribers/user-1" {
+			w.Write([]byte(`{"data":{"subscriberId":"user-1"}}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"statusCode":404,"message":"Subscriber not found"}`))
+	}))
+	defer httpServer.Close()
+
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	_, err := c.SubscriberApi.MergeSubscribers(context.Background(), "user-1", "missing")
+	require.ErrorIs(t, err, lib.ErrSubscriberNotFound)
+
+	_, err = c.SubscriberApi.MergeSubscribers(context.Background(), "user-1", "user-1")
+	require.EqualError(t, err, "cannot merge a subscriber into itself")
+}
+
+func TestSubscriberService_MergeSubscribers_PartialFailure(t *testing.T) {
+	httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.Method + " " + req.URL.Path {
+		case "GET /v1/subscribers/user-1":
+			w.Write([]byte(`{"data":{"subscriberId":"user-1"}}`))
+		case "GET /v1/subscribers/anon-1":
+			w.Write([]byte(`{"data":{"subscriberId":"anon-1","email":"jane@example.com",
+				"channels":[{"providerId":"fcm","credentials":{"deviceTokens":["token"]}}]}}`))
+		case "PUT /v1/subscribers/user-1":
+			w.Write([]byte(`{"data":{}}`))
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"statusCode":500,"message":"Internal server error"}`))
+		}
+	}))
+	defer httpServer.Close()
+
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	result, err := c.SubscriberApi.MergeSubscribers(context.Background(), "user-1", "anon-1")
+
+	require.ErrorContains(t, err, "failed to merge the fcm credentials")
+	assert.Equal(t, &lib.MergeSubscribersResult{ProfileMerged: true}, result)
+}
+
+func TestSubscriberService_Upsert(t *testing.T) {
+	var expectedResponse lib.SubscriberResponse
+	fileToStruct(filepath.Join("../testdata", "subscriber_response.json"), &expectedResponse)
+
+	for name, conflict := range map[string]bool{"created": false, "conflict": true} {
+		t.Run(name, func(t *testing.T) {
+			var methods []string
+			httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				methods = append(methods, req.Method+" "+req.RequestURI)
+				if req.Method == http.MethodPost && conflict {
+					w.WriteHeader(http.StatusConflict)
+					w.Write([]byte(`{"statusCode":409,"message":"Subscriber already exists"}`))
+					return
+				}
+				json.NewEncoder(w).Encode(expectedResponse)
+			}))
+			defer httpServer.Close()
+
+			c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+			resp, err := c.SubscriberApi.Upsert(context.Background(), subscriberID, map[string]interface{}{"firstName": "John"})
+
+			require.NoError(t, err)
+			assert.Equal(t, expectedResponse, resp)
+			expectedMethods := []string{"POST /v1/subscribers"}
+			if conflict {
+				expectedMethods = append(expectedMethods, "PUT /v1/subscribers/"+subscriberID)
+			}
+			assert.Equal(t, expectedMethods, methods)
+		})
+	}
+}
+
+func TestSubscriberService_GetCredentials(t *testing.T) {
+	httpServer := createTestServer(t, TestServerOptions[io.Reader, map[string]interface{}]{
+		expectedURLPath:    fmt.Sprintf("/v1/subscribers/%s", subscriberID),
+		expectedSentMethod: http.MethodGet,
+		expectedSentBody:   http.NoBody,
+		responseStatusCode: http.StatusOK,
+		responseBody: map[string]interface{}{
+			"data": map[string]interface{}{
+				"subscriberId": subscriberID,
+				"channels": []interface{}{
+					map[string]interface{}{
+						"providerId":            "fcm",
+						"integrationIdentifier": "fcm-production",
+						"credentials":           map[string]interface{}{"deviceTokens": []interface{}{"token"}},
+					},
+				},
+			},
+		},
+	})
+
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	credentials, err := c.SubscriberApi.GetCredentials(context.Background(), subscriberID)
+
+	require.NoError(t, err)
+	assert.Equal(t, []lib.SubscriberChannelCredential{{
+		ProviderId:            lib.ProviderIDFCM,
+		IntegrationIdentifier: "fcm-production",
+		Credentials:           map[string]interface{}{"deviceTokens": []interface{}{"token"}},
+	}}, credentials)
+}
+
+func TestSubscriberService_DeleteCredentials(t *testing.T) {
+	httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, http.MethodDelete, req.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/subscribers/%s/credentials/fcm", subscriberID), req.RequestURI)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer httpServer.Close()
+
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	err := c.SubscriberApi.DeleteCredentials(context.Background(), subscriberID, "fcm")
+
+	require.NoError(t, err)
+}
+
+func TestSubscriberService_GetUnseenCount_WithFeedIdentifier(t *testing.T) {
+	expectedResponse := &lib.SubscriberUnseenCountResponse{}
+	expectedResponse.Data.Count = 4
+
+	httpServer := createTestServer(t, TestServerOptions[io.Reader, *lib.SubscriberUnseenCountResponse]{
+		expectedURLPath:    fmt.Sprintf("/v1/subscribers/%s/notifications/unseen?feedIdentifier=feed_identifier", subscriberID),
+		expectedSentMethod: http.MethodGet,
+		expectedSentBody:   http.NoBody,
+		responseStatusCode: http.StatusOK,
+		responseBody:       expectedResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.SubscriberApi.GetUnseenCount(ctx, subscriberID, &lib.SubscriberUnseenCountOptions{FeedIdentifier: "feed_identifier"})
+
+	require.NoError(t, err)
+	require.Equal(t, 4, resp.Data.Count)
+}
+
+func TestSubscriberService_GetUnreadCount(t *testing.T) {
+	expectedResponse := &lib.SubscriberUnreadCountResponse{}
+	expectedResponse.Data.Count = 2
+
+	read := false
+	httpServer := createTestServer(t, TestServerOptions[io.Reader, *lib.SubscriberUnreadCountResponse]{
+		expectedURLPath:    fmt.Sprintf("/v1/subscribers/%s/notifications/unread?feedIdentifier=feed_identifier&read=false", subscriberID),
+		expectedSentMethod: http.MethodGet,
+		expectedSentBody:   http.NoBody,
+		responseStatusCode: http.StatusOK,
+		responseBody:       expectedResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.SubscriberApi.GetUnreadCount(ctx, subscriberID, &lib.SubscriberUnreadCountOptions{Read: &read, FeedIdentifier: "feed_identifier"})
+
+	require.NoError(t, err)
+	require.Equal(t, expectedResponse, resp)
+}
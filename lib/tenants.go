@@ -9,11 +9,10 @@ import (
 
 type TenantService service
 
-func (e *TenantService) CreateTenant(ctx context.Context, name string,identifier string) (JsonResponse, error) {
+func (e *TenantService) CreateTenant(ctx context.Context, request CreateTenantRequest) (JsonResponse, error) {
 	var resp JsonResponse
 	URL := e.client.config.BackendURL.JoinPath("tenants")
-	n := map[string]string{"name": name,"identifier":identifier}
-	jsonBody, _ := json.Marshal(n)
+	jsonBody, _ := json.Marshal(request)
 	b := bytes.NewBuffer(jsonBody)
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, URL.String(), b)
 	if err != nil {
@@ -26,12 +25,12 @@ func (e *TenantService) CreateTenant(ctx context.Context, name string,identifier
 	return resp, nil
 }
 
-func (e *TenantService) GetTenants(ctx context.Context,page string,limit string) (JsonResponse, error) {
+func (e *TenantService) GetTenants(ctx context.Context, page string, limit string) (JsonResponse, error) {
 	var resp JsonResponse
 	URL := e.client.config.BackendURL.JoinPath("tenants")
-	v := URL.Query();
-	v.Set("page",page)
-	v.Set("limit",limit)
+	v := URL.Query()
+	v.Set("page", page)
+	v.Set("limit", limit)
 	URL.RawQuery = v.Encode()
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, URL.String(), http.NoBody)
 	if err != nil {
@@ -44,9 +43,9 @@ func (e *TenantService) GetTenants(ctx context.Context,page string,limit string)
 	return resp, nil
 }
 
-func (e *TenantService) GetTenant(ctx context.Context,identifier string) (JsonResponse, error) {
+func (e *TenantService) GetTenant(ctx context.Context, identifier string) (JsonResponse, error) {
 	var resp JsonResponse
-	URL := e.client.config.BackendURL.JoinPath("tenants",identifier)
+	URL := e.client.config.BackendURL.JoinPath("tenants", identifier)
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, URL.String(), http.NoBody)
 	if err != nil {
 		return resp, err
@@ -72,8 +71,7 @@ func (e *TenantService) DeleteTenant(ctx context.Context, identifier string) (Js
 	return resp, nil
 }
 
-
-func (e *TenantService) UpdateTenant(ctx context.Context, identifier string,updateTenantObject *UpdateTenantRequest) (JsonResponse, error) {
+func (e *TenantService) UpdateTenant(ctx context.Context, identifier string, updateTenantObject *UpdateTenantRequest) (JsonResponse, error) {
 	var resp JsonResponse
 	URL := e.client.config.BackendURL.JoinPath("tenants", identifier)
 	jsonBody, _ := json.Marshal(updateTenantObject)
@@ -2,6 +2,7 @@ package lib_test
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -31,12 +32,21 @@ func TestCreateTenant(t *testing.T) {
 		if r.URL.Path != "/v1/tenants" {
 			t.Errorf("Want /v1/tenants, got %s", r.URL.Path)
 		}
+		var body lib.CreateTenantRequest
+		json.NewDecoder(r.Body).Decode(&body)
+		if body.Identifier != "TenantId" || body.Name != "Tenant" || body.Data["plan"] != "enterprise" {
+			t.Errorf("Want tenant TenantId with data, got %+v", body)
+		}
 		w.Header().Set("Content-Type", "application/json")
 		w.Write([]byte(tenantsApiResponse))
 	}))
 	defer server.Close()
 	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(server.URL)})
-	resp, err := c.TenantApi.CreateTenant(context.Background(), "Tenant", "TenantId")
+	resp, err := c.TenantApi.CreateTenant(context.Background(), lib.CreateTenantRequest{
+		Identifier: "TenantId",
+		Name:       "Tenant",
+		Data:       map[string]interface{}{"plan": "enterprise"},
+	})
 	if err != nil {
 		t.Errorf("Error should be nil, got %v", err)
 	}
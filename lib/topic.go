@@ -5,6 +5,8 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"strconv"
+	"sync"
 
 	"github.com/pkg/errors"
 )
@@ -13,8 +15,10 @@ type ITopic interface {
 	Create(ctx context.Context, key string, name string) error
 	List(ctx context.Context, options *ListTopicsOptions) (*ListTopicsResponse, error)
 	CheckTopicSubscriber(ctx context.Context, key string, externalsubscriber string) (*CheckTopicSubscriberResponse, error)
-	AddSubscribers(ctx context.Context, key string, subscribers []string) error
-	RemoveSubscribers(ctx context.Context, key string, subscribers []string) error
+	GetTopicSubscribers(ctx context.Context, key string, page int, limit int) (*SubscriberListResponse, error)
+	AddSubscribers(ctx context.Context, key string, subscribers []string) (*TopicSubscribersResponse, error)
+	BulkAddSubscribers(ctx context.Context, key string, subscribers []string, opts *AddSubscriberOptions) (*BulkAddResult, error)
+	RemoveSubscribers(ctx context.Context, key string, subscribers []string) (*TopicSubscribersResponse, error)
 	Get(ctx context.Context, key string) (*GetTopicResponse, error)
 	Rename(ctx context.Context, key string, name string) (*GetTopicResponse, error)
 	Delete(ctx context.Context, key string) error
@@ -54,12 +58,21 @@ func (t *TopicService) List(ctx context.Context, options *ListTopicsOptions) (*L
 	var resp ListTopicsResponse
 	URL := t.client.config.BackendURL.JoinPath("topics")
 
-	if options == nil {
-		options = &ListTopicsOptions{}
+	if options != nil {
+		queryValues := URL.Query()
+		if options.Page != nil {
+			queryValues.Set("page", strconv.Itoa(*options.Page))
+		}
+		if options.PageSize != nil {
+			queryValues.Set("pageSize", strconv.Itoa(*options.PageSize))
+		}
+		if options.Key != nil {
+			queryValues.Set("key", *options.Key)
+		}
+		URL.RawQuery = queryValues.Encode()
 	}
-	queryParams, _ := json.Marshal(options)
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, URL.String(), bytes.NewBuffer(queryParams))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, URL.String(), http.NoBody)
 	if err != nil {
 		return nil, err
 	}
@@ -89,7 +102,31 @@ func (t *TopicService) CheckTopicSubscriber(ctx context.Context, key string, ext
 	return &resp, nil
 }
 
-func (t *TopicService) AddSubscribers(ctx context.Context, key string, subscribers []string) error {
+// GetTopicSubscribers lists the subscribers enrolled in the topic.
+func (t *TopicService) GetTopicSubscribers(ctx context.Context, key string, page int, limit int) (*SubscriberListResponse, error) {
+	var resp SubscriberListResponse
+	URL := t.client.config.BackendURL.JoinPath("topics", key, "subscribers")
+
+	queryValues := URL.Query()
+	queryValues.Set("page", strconv.Itoa(page))
+	queryValues.Set("limit", strconv.Itoa(limit))
+	URL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, URL.String(), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = t.client.sendRequest(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+func (t *TopicService) AddSubscribers(ctx context.Context, key string, subscribers []string) (*TopicSubscribersResponse, error) {
+	var resp TopicSubscribersResponse
 	URL := t.client.config.BackendURL.JoinPath("topics", key, "subscribers")
 
 	queryParams, _ := json.Marshal(SubscribersTopicRequest{
@@ -98,19 +135,99 @@ func (t *TopicService) AddSubscribers(ctx context.Context, key string, subscribe
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, URL.String(), bytes.NewBuffer(queryParams))
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = t.client.sendRequest(req, nil)
+	_, err = t.client.sendRequest(req, &resp)
 
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	return &resp, nil
 }
 
-func (t *TopicService) RemoveSubscribers(ctx context.Context, key string, subscribers []string) error {
+// BulkAddSubscribers adds any number of subscribers to the topic, splitting
+// them into batches of MaxTopicSubscribersBatchSize sent concurrently. A failed
+// batch does not stop the others: its subscribers are reported in FailedToAdd
+// along with those the API could not find. No batch is sent once ctx is done:
+// the error is ctx.Err() when a batch was skipped or failed because of it, and
+// is nil when every batch completed, even if ctx is done by then.
+func (t *TopicService) BulkAddSubscribers(ctx context.Context, key string, subscribers []string, opts *AddSubscriberOptions) (*BulkAddResult, error) {
+	concurrency := defaultAddSubscribersConcurrency
+	if opts != nil && opts.Concurrency > 0 {
+		concurrency = opts.Concurrency
+	}
+
+	var batches [][]string
+	for start := 0; start < len(subscribers); start += MaxTopicSubscribersBatchSize {
+		end := start + MaxTopicSubscribersBatchSize
+		if end > len(subscribers) {
+			end = len(subscribers)
+		}
+		batches = append(batches, subscribers[start:end])
+	}
+
+	//results are stored per batch so the outcome keeps the order of subscribers
+	results := make([]BulkAddResult, len(batches))
+	canceled := make([]bool, len(batches))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	failBatch := func(i int, err error) {
+		for _, subscriberID := range batches[i] {
+			results[i].FailedToAdd = append(results[i].FailedToAdd, BulkAddError{SubscriberID: subscriberID, Error: err.Error()})
+		}
+		canceled[i] = ctx.Err() != nil && errors.Is(err, ctx.Err())
+	}
+
+	for i, batch := range batches {
+		select {
+		case <-ctx.Done():
+		case sem <- struct{}{}:
+		}
+		if ctx.Err() != nil {
+			for j := i; j < len(batches); j++ {
+				failBatch(j, ctx.Err())
+			}
+			break
+		}
+
+		wg.Add(1)
+		go func(i int, batch []string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			resp, err := t.AddSubscribers(ctx, key, batch)
+			if err != nil {
+				failBatch(i, err)
+				return
+			}
+
+			results[i].Added = resp.Data.Succeeded
+			for _, subscriberID := range resp.Data.Failed.NotFound {
+				results[i].FailedToAdd = append(results[i].FailedToAdd, BulkAddError{SubscriberID: subscriberID, Error: "subscriber not found"})
+			}
+		}(i, batch)
+	}
+	wg.Wait()
+
+	result := BulkAddResult{Added: []string{}, FailedToAdd: []BulkAddError{}}
+	var err error
+	for i, r := range results {
+		result.Added = append(result.Added, r.Added...)
+		result.FailedToAdd = append(result.FailedToAdd, r.FailedToAdd...)
+		if canceled[i] {
+			err = ctx.Err()
+		}
+	}
+	result.TotalAdded = len(result.Added)
+
+	return &result, err
+}
+
+func (t *TopicService) RemoveSubscribers(ctx context.Context, key string, subscribers []string) (*TopicSubscribersResponse, error) {
+	var resp TopicSubscribersResponse
 	URL := t.client.config.BackendURL.JoinPath("topics", key, "subscribers/removal")
 
 	queryParams, _ := json.Marshal(SubscribersTopicRequest{
@@ -119,15 +236,15 @@ func (t *TopicService) RemoveSubscribers(ctx context.Context, key string, subscr
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, URL.String(), bytes.NewBuffer(queryParams))
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = t.client.sendRequest(req, nil)
+	_, err = t.client.sendRequest(req, &resp)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	return &resp, nil
 }
 
 func (t *TopicService) Get(ctx context.Context, key string) (*GetTopicResponse, error) {
@@ -147,6 +264,8 @@ func (t *TopicService) Get(ctx context.Context, key string) (*GetTopicResponse,
 	return &resp, nil
 }
 
+// Rename changes the display name of the topic. Its key stays the same, so
+// triggers and subscriptions using it are unaffected.
 func (t *TopicService) Rename(ctx context.Context, key string, name string) (*GetTopicResponse, error) {
 	var resp GetTopicResponse
 	URL := t.client.config.BackendURL.JoinPath("topics", key)
@@ -186,3 +305,13 @@ func (t *TopicService) Delete(ctx context.Context, key string) error {
 
 	return nil
 }
+
+func (t *TopicService) Iterate(pageSize int) *Paginator[GetTopicResponse] {
+	return NewPaginator(func(ctx context.Context, page int) ([]GetTopicResponse, int, error) {
+		resp, err := t.List(ctx, &ListTopicsOptions{Page: &page, PageSize: &pageSize})
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Data, resp.TotalCount, nil
+	})
+}
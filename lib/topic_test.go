@@ -1,13 +1,16 @@
 package lib_test
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"log"
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/novuhq/go-novu/lib"
@@ -99,7 +102,7 @@ func TestCheckTopicSubscriber_Success(t *testing.T) {
 		expectedSentMethod: http.MethodGet,
 		expectedSentBody:   map[string]string{},
 		responseStatusCode: http.StatusOK,
-		responseBody:       lib.CheckTopicSubscriberResponse{
+		responseBody: lib.CheckTopicSubscriberResponse{
 			ExternalSubscriberId: subscriber,
 		},
 	})
@@ -111,25 +114,162 @@ func TestCheckTopicSubscriber_Success(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestGetTopicSubscribers_Success(t *testing.T) {
+	topicKey := "topicKey"
+	expectedResponse := lib.SubscriberListResponse{
+		Page:       1,
+		PageSize:   10,
+		TotalCount: 1,
+		Data:       []lib.Subscriber{{SubscriberId: "subId"}},
+	}
+
+	httpServer := createTestServer(t, TestServerOptions[io.Reader, lib.SubscriberListResponse]{
+		expectedURLPath:    fmt.Sprintf("/v1/topics/%s/subscribers?limit=10&page=1", topicKey),
+		expectedSentMethod: http.MethodGet,
+		expectedSentBody:   http.NoBody,
+		responseStatusCode: http.StatusOK,
+		responseBody:       expectedResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.TopicsApi.GetTopicSubscribers(ctx, topicKey, 1, 10)
+
+	require.NoError(t, err)
+	require.Equal(t, &expectedResponse, resp)
+}
+
 func TestAddSubscription_Success(t *testing.T) {
 	subs := []string{"subId"}
 	key := "topicKey"
 
-	httpServer := createTestServer(t, TestServerOptions[lib.SubscribersTopicRequest, map[string]string]{
+	expectedResponse := lib.TopicSubscribersResponse{
+		Data: lib.TopicSubscribersResult{
+			Succeeded: subs,
+			Failed: lib.TopicSubscribersFailed{
+				NotFound: []string{"missingSubId"},
+			},
+		},
+	}
+
+	httpServer := createTestServer(t, TestServerOptions[lib.SubscribersTopicRequest, lib.TopicSubscribersResponse]{
 		expectedURLPath:    fmt.Sprintf("/v1/topics/%s/subscribers", key),
 		expectedSentMethod: http.MethodPost,
 		expectedSentBody: lib.SubscribersTopicRequest{
 			Subscribers: subs,
 		},
-		responseStatusCode: http.StatusNoContent,
-		responseBody:       map[string]string{},
+		responseStatusCode: http.StatusOK,
+		responseBody:       expectedResponse,
 	})
 
 	ctx := context.Background()
 	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
-	err := c.TopicsApi.AddSubscribers(ctx, key, subs)
+	resp, err := c.TopicsApi.AddSubscribers(ctx, key, subs)
+
+	require.NoError(t, err)
+	require.Equal(t, &expectedResponse, resp)
+}
+
+func TestBulkAddSubscribers_Success(t *testing.T) {
+	key := "topicKey"
+
+	subs := make([]string, 250)
+	for i := range subs {
+		subs[i] = fmt.Sprintf("subId%d", i)
+	}
+
+	var mu sync.Mutex
+	var batchSizes []int
+	httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, fmt.Sprintf("/v1/topics/%s/subscribers", key), req.RequestURI)
+
+		var body lib.SubscribersTopicRequest
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+
+		mu.Lock()
+		batchSizes = append(batchSizes, len(body.Subscribers))
+		mu.Unlock()
+
+		if body.Subscribers[0] == "subId100" {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"statusCode":500,"message":"Internal server error"}`))
+			return
+		}
+
+		resp := lib.TopicSubscribersResponse{Data: lib.TopicSubscribersResult{Succeeded: body.Subscribers[1:]}}
+		resp.Data.Failed.NotFound = body.Subscribers[:1]
+		bb, _ := json.Marshal(resp)
+		w.WriteHeader(http.StatusOK)
+		w.Write(bb)
+	}))
+	defer httpServer.Close()
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.TopicsApi.BulkAddSubscribers(ctx, key, subs, &lib.AddSubscriberOptions{Concurrency: 2})
+
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []int{100, 100, 50}, batchSizes)
+	assert.Equal(t, 148, resp.TotalAdded)
+	assert.Equal(t, append(append([]string{}, subs[1:100]...), subs[201:]...), resp.Added)
+	require.Len(t, resp.FailedToAdd, 102)
+	assert.Equal(t, lib.BulkAddError{SubscriberID: "subId0", Error: "subscriber not found"}, resp.FailedToAdd[0])
+	assert.Equal(t, "subId100", resp.FailedToAdd[1].SubscriberID)
+	assert.Contains(t, resp.FailedToAdd[1].Error, "status code 500")
+	assert.Equal(t, "subId200", resp.FailedToAdd[101].SubscriberID)
+}
+
+func TestBulkAddSubscribers_Canceled(t *testing.T) {
+	subs := make([]string, 250)
+	for i := range subs {
+		subs[i] = fmt.Sprintf("subId%d", i)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var mu sync.Mutex
+	requests := 0
+	httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		mu.Lock()
+		requests++
+		mu.Unlock()
+		cancel()
+		w.Write([]byte(`{"data":{"succeeded":[]}}`))
+	}))
+	defer httpServer.Close()
+
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.TopicsApi.BulkAddSubscribers(ctx, "topicKey", subs, &lib.AddSubscriberOptions{Concurrency: 1})
+
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 1, requests, "no batch is sent once ctx is done")
+	assert.GreaterOrEqual(t, len(resp.FailedToAdd), 150)
+	assert.Equal(t, lib.BulkAddError{SubscriberID: "subId249", Error: context.Canceled.Error()}, resp.FailedToAdd[len(resp.FailedToAdd)-1])
+}
+
+func TestBulkAddSubscribers_CanceledAfterLastBatch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(`{"data":{"succeeded":["subId"]}}`))
+	}))
+	defer httpServer.Close()
+
+	// cancels ctx once the only batch has its response, before BulkAddSubscribers returns
+	cancelAfterResponse := func(req *http.Request, next http.RoundTripper) (*http.Response, error) {
+		res, err := next.RoundTrip(req)
+		if err == nil {
+			body, _ := io.ReadAll(res.Body)
+			res.Body = io.NopCloser(bytes.NewReader(body))
+		}
+		cancel()
+		return res, err
+	}
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL), Middlewares: []lib.Middleware{cancelAfterResponse}})
+	resp, err := c.TopicsApi.BulkAddSubscribers(ctx, "topicKey", []string{"subId"}, nil)
 
 	require.NoError(t, err)
+	assert.Equal(t, 1, resp.TotalAdded)
 }
 
 func TestAddSubscriptionRemoval_Success(t *testing.T) {
@@ -148,7 +288,7 @@ func TestAddSubscriptionRemoval_Success(t *testing.T) {
 
 	ctx := context.Background()
 	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
-	err := c.TopicsApi.RemoveSubscribers(ctx, key, subs)
+	_, err := c.TopicsApi.RemoveSubscribers(ctx, key, subs)
 
 	require.NoError(t, err)
 }
@@ -213,6 +353,33 @@ func TestListTopics_Success(t *testing.T) {
 	require.Equal(t, resp, expectedResponse)
 }
 
+func TestListTopics_WithOptions_Success(t *testing.T) {
+	page := 1
+	pageSize := 10
+	key := "topicKey"
+	expectedResponse := &lib.ListTopicsResponse{
+		Page:       page,
+		PageSize:   pageSize,
+		TotalCount: 0,
+		Data:       []lib.GetTopicResponse{},
+	}
+
+	httpServer := createTestServer(t, TestServerOptions[map[string]string, *lib.ListTopicsResponse]{
+		expectedURLPath:    "/v1/topics?key=topicKey&page=1&pageSize=10",
+		expectedSentMethod: http.MethodGet,
+		expectedSentBody:   map[string]string{},
+		responseStatusCode: http.StatusOK,
+		responseBody:       expectedResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.TopicsApi.List(ctx, &lib.ListTopicsOptions{Page: &page, PageSize: &pageSize, Key: &key})
+
+	require.NoError(t, err)
+	require.Equal(t, expectedResponse, resp)
+}
+
 func TestRenameTopic_Success(t *testing.T) {
 	topicKey := "topicKey"
 	newName := "topicName"
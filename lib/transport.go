@@ -0,0 +1,55 @@
+package lib
+
+import (
+	"crypto/tls"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/go-cleanhttp"
+)
+
+// TransportOptions tunes the connection pool of the client built by
+// NewAPIClient. Zero fields keep their default, set for sending many requests
+// to the single Novu API host.
+type TransportOptions struct {
+	MaxIdleConns        int           // Idle connections kept across all hosts, defaults to 100
+	MaxIdleConnsPerHost int           // Idle connections kept to the API host, defaults to 100
+	IdleConnTimeout     time.Duration // How long an idle connection is kept, defaults to 90s
+	TLSHandshakeTimeout time.Duration // Defaults to 10s
+	DisableHTTP2        bool          // Only speak HTTP/1.1
+}
+
+const (
+	defaultMaxIdleConns        = 100
+	defaultMaxIdleConnsPerHost = 100
+	defaultIdleConnTimeout     = 90 * time.Second
+	defaultTLSHandshakeTimeout = 10 * time.Second
+)
+
+func newTransport(opts *TransportOptions) *http.Transport {
+	if opts == nil {
+		opts = &TransportOptions{}
+	}
+
+	transport := cleanhttp.DefaultPooledTransport()
+	transport.MaxIdleConns = valueOrDefault(opts.MaxIdleConns, defaultMaxIdleConns)
+	//Go keeps only 2 idle connections per host by default, which forces new
+	//connections as soon as more requests are sent concurrently
+	transport.MaxIdleConnsPerHost = valueOrDefault(opts.MaxIdleConnsPerHost, defaultMaxIdleConnsPerHost)
+	transport.IdleConnTimeout = valueOrDefault(opts.IdleConnTimeout, defaultIdleConnTimeout)
+	transport.TLSHandshakeTimeout = valueOrDefault(opts.TLSHandshakeTimeout, defaultTLSHandshakeTimeout)
+	if opts.DisableHTTP2 {
+		transport.ForceAttemptHTTP2 = false
+		//a non-nil empty map disables the automatic HTTP/2 upgrade
+		transport.TLSNextProto = map[string]func(string, *tls.Conn) http.RoundTripper{}
+	}
+
+	return transport
+}
+
+func valueOrDefault[T int | time.Duration](value T, def T) T {
+	if value > 0 {
+		return value
+	}
+	return def
+}
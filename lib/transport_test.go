@@ -0,0 +1,40 @@
+package lib
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewTransport_Defaults(t *testing.T) {
+	transport := newTransport(nil)
+
+	if transport.MaxIdleConns != 100 || transport.MaxIdleConnsPerHost != 100 {
+		t.Errorf("newTransport() idle connections = %d/%d, want 100/100", transport.MaxIdleConns, transport.MaxIdleConnsPerHost)
+	}
+	if transport.IdleConnTimeout != 90*time.Second || transport.TLSHandshakeTimeout != 10*time.Second {
+		t.Errorf("newTransport() timeouts = %v/%v, want 90s/10s", transport.IdleConnTimeout, transport.TLSHandshakeTimeout)
+	}
+	if !transport.ForceAttemptHTTP2 || transport.TLSNextProto != nil {
+		t.Errorf("newTransport() should attempt HTTP/2 by default")
+	}
+}
+
+func TestNewTransport_Options(t *testing.T) {
+	transport := newTransport(&TransportOptions{
+		MaxIdleConns:        500,
+		MaxIdleConnsPerHost: 250,
+		IdleConnTimeout:     time.Minute,
+		TLSHandshakeTimeout: 5 * time.Second,
+		DisableHTTP2:        true,
+	})
+
+	if transport.MaxIdleConns != 500 || transport.MaxIdleConnsPerHost != 250 {
+		t.Errorf("newTransport() idle connections = %d/%d, want 500/250", transport.MaxIdleConns, transport.MaxIdleConnsPerHost)
+	}
+	if transport.IdleConnTimeout != time.Minute || transport.TLSHandshakeTimeout != 5*time.Second {
+		t.Errorf("newTransport() timeouts = %v/%v, want 1m/5s", transport.IdleConnTimeout, transport.TLSHandshakeTimeout)
+	}
+	if transport.ForceAttemptHTTP2 || transport.TLSNextProto == nil {
+		t.Errorf("newTransport() should not attempt HTTP/2 when DisableHTTP2 is set")
+	}
+}
@@ -2,6 +2,7 @@ package lib
 
 import (
 	"errors"
+	"fmt"
 	"net/url"
 	"reflect"
 	"strconv"
@@ -16,6 +17,21 @@ func MustParseURL(rawURL string) *url.URL {
 	return u
 }
 
+// ParseBackendURL parses the URL of a self-hosted Novu API for Config.BackendURL,
+// returning an error unless it has both a scheme and a host.
+func ParseBackendURL(rawURL string) (*url.URL, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("backend URL %q must have a scheme and a host, e.g. https://novu.example.com", rawURL)
+	}
+
+	return u, nil
+}
+
 type QueryParam struct {
 	Key   string
 	Value string
@@ -39,17 +55,22 @@ func GenerateQueryParamsFromStruct[T interface{}](queryParamsStruct T) ([]QueryP
 				key = types.Field(i).Name
 			}
 
+			// Pointer fields let callers send an explicit zero value such as seen=false
+			if field.Kind() == reflect.Ptr {
+				field = field.Elem()
+			}
+
 			// Check if the field is a string, bool or int and convert it to string
 			var value string
 			switch field.Kind() {
 			case reflect.String:
-				value = field.Interface().(string)
+				value = field.String()
 
 			case reflect.Bool:
-				value = strconv.FormatBool(field.Interface().(bool))
+				value = strconv.FormatBool(field.Bool())
 
 			case reflect.Int:
-				value = strconv.FormatInt(int64(field.Interface().(int)), 10)
+				value = strconv.FormatInt(field.Int(), 10)
 
 			default:
 				return nil, errors.New("unsupported type in struct field. Supported types are: string, bool and int")
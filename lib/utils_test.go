@@ -99,6 +99,25 @@ func TestGenerateQueryParamsFromStruct(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "should dereference pointer fields and keep explicit zero values",
+			args: args{
+				queryParams: struct {
+					Seen  *bool `queryKey:"seen"`
+					Read  *bool `queryKey:"read"`
+					Limit *int  `queryKey:"limit"`
+				}{
+					Seen: new(bool),
+				},
+			},
+			want: []QueryParam{
+				{
+					Key:   "seen",
+					Value: "false",
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "should throw error if nil is passed",
 			args: args{
@@ -120,3 +139,27 @@ func TestGenerateQueryParamsFromStruct(t *testing.T) {
 		})
 	}
 }
+
+func TestParseBackendURL(t *testing.T) {
+	tests := []struct {
+		rawURL  string
+		wantErr bool
+	}{
+		{rawURL: "https://novu.example.com", wantErr: false},
+		{rawURL: "http://localhost:3000", wantErr: false},
+		{rawURL: "novu.example.com", wantErr: true},
+		{rawURL: "https://", wantErr: true},
+		{rawURL: "://novu.example.com", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.rawURL, func(t *testing.T) {
+			u, err := ParseBackendURL(tt.rawURL)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseBackendURL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && u.String() != tt.rawURL {
+				t.Errorf("ParseBackendURL() = %v, want %v", u, tt.rawURL)
+			}
+		})
+	}
+}
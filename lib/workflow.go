@@ -0,0 +1,800 @@
+package lib
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrMissingWorkflowId is returned when a workflow id is required but empty.
+var ErrMissingWorkflowId = errors.New("workflow id is required")
+
+// ErrWorkflowExists is returned by CloneWorkflowToEnvironment when the target
+// environment already has a workflow with the same trigger identifier.
+var ErrWorkflowExists = errors.New("workflow already exists")
+
+// searchPageSize is the page size used to search workflows by trigger
+// identifier.
+const searchPageSize = 100
+
+// statsPageSize is the page size used to read notifications and messages in
+// GetWorkflowChannelStats.
+const statsPageSize = 100
+
+// defaultStatsMaxNotifications bounds the notifications GetWorkflowChannelStats
+// scans when no MaxNotifications is given.
+const defaultStatsMaxNotifications = 1000
+
+// preferenceLookupConcurrency bounds the preference requests sent at once by
+// GetWorkflowDisabledSubscribers.
+const preferenceLookupConcurrency = 5
+
+type IWorkflow interface {
+	CreateWorkflow(ctx context.Context, request CreateWorkflowRequest) (*WorkflowResponse, error)
+	UpdateWorkflow(ctx context.Context, workflowId string, request UpdateWorkflowRequest) (*WorkflowResponse, error)
+	GetWorkflow(ctx context.Context, workflowId string) (*WorkflowResponse, error)
+	GetDeletedWorkflow(ctx context.Context, workflowId string) (*WorkflowResponse, error)
+	GetWorkflows(ctx context.Context, page int, limit int) (*WorkflowListResponse, error)
+	GetWorkflowsPage(ctx context.Context, opts PageOptions) (*PagedResult[Workflow], error)
+	GetWorkflowsCount(ctx context.Context) (int, error)
+	SearchWorkflows(ctx context.Context, query string, page int, limit int) (*WorkflowListResponse, error)
+	GetWorkflowsFiltered(ctx context.Context, filter GetWorkflowsFilter, page int, limit int) (*WorkflowListResponse, error)
+	GetWorkflowsByNotificationGroup(ctx context.Context, notificationGroupId string, page int, limit int) (*WorkflowListResponse, error)
+	GetWorkflowGroups(ctx context.Context) (*NotificationGroupsResponse, error)
+	DeleteWorkflow(ctx context.Context, workflowId string) error
+	UpdateWorkflowStatus(ctx context.Context, workflowId string, active bool) (*WorkflowResponse, error)
+	BulkUpdateWorkflowStatus(ctx context.Context, workflowIds []string, active bool) (*BulkUpdateResult, error)
+	PauseWorkflow(ctx context.Context, workflowId string) (*WorkflowResponse, error)
+	ResumeWorkflow(ctx context.Context, workflowId string) (*WorkflowResponse, error)
+	UpdateWorkflowNotificationGroup(ctx context.Context, workflowId string, notificationGroupId string) (*WorkflowResponse, error)
+	UpdateWorkflowCriticalFlag(ctx context.Context, workflowId string, critical bool) (*WorkflowResponse, error)
+	DuplicateWorkflow(ctx context.Context, workflowId string) (*WorkflowResponse, error)
+	TestSendWorkflow(ctx context.Context, workflowId string, request TestSendRequest) (*TestSendResponse, error)
+	CloneWorkflowToEnvironment(ctx context.Context, workflowId string, target *APIClient) (*WorkflowResponse, error)
+	GetWorkflowSteps(ctx context.Context, workflowId string) ([]WorkflowStep, error)
+	UpdateWorkflowStep(ctx context.Context, workflowId string, stepId string, request UpdateStepRequest) (*WorkflowStepResponse, error)
+	PreviewWorkflowStep(ctx context.Context, workflowId string, stepId string, data map[string]interface{}) (*StepPreviewResponse, error)
+	GetWorkflowVariables(ctx context.Context, workflowId string) (*WorkflowVariablesResponse, error)
+	GetWorkflowChangeHistory(ctx context.Context, workflowId string, promoted bool, opts PageOptions) (*PagedResult[WorkflowChange], error)
+	GetWorkflowDisabledSubscribers(ctx context.Context, workflowId string, page int, limit int) (*SubscriberListResponse, error)
+	GetWorkflowChannelStats(ctx context.Context, workflowId string, opts WorkflowChannelStatsOptions) (*WorkflowChannelStats, error)
+}
+
+type WorkflowService service
+
+// Validate checks the fields the API requires to create a workflow.
+func (r CreateWorkflowRequest) Validate() error {
+	if r.Name == "" {
+		return errors.New("workflow name is required")
+	}
+	if r.NotificationGroupId == "" {
+		return errors.New("workflow notification group id is required")
+	}
+	if len(r.Steps) == 0 {
+		return errors.New("workflow requires at least one step")
+	}
+	return nil
+}
+
+// CreateWorkflow creates the workflow after validating the request, so missing
+// required fields fail without calling the API.
+func (w *WorkflowService) CreateWorkflow(ctx context.Context, request CreateWorkflowRequest) (*WorkflowResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	var resp WorkflowResponse
+	URL := w.client.config.BackendURL.JoinPath("workflows")
+
+	jsonBody, _ := json.Marshal(request)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, URL.String(), bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = w.client.sendRequest(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+func (w *WorkflowService) UpdateWorkflow(ctx context.Context, workflowId string, request UpdateWorkflowRequest) (*WorkflowResponse, error) {
+	var resp WorkflowResponse
+	URL := w.client.config.BackendURL.JoinPath("workflows", workflowId)
+
+	jsonBody, _ := json.Marshal(request)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, URL.String(), bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = w.client.sendRequest(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetWorkflow fetches a single workflow by its id.
+func (w *WorkflowService) GetWorkflow(ctx context.Context, workflowId string) (*WorkflowResponse, error) {
+	var resp WorkflowResponse
+	URL := w.client.config.BackendURL.JoinPath("workflows", workflowId)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, URL.String(), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = w.client.sendRequest(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetDeletedWorkflow fetches a soft-deleted workflow, so its configuration can
+// be inspected before it is re-created. Deleted, DeletedAt and DeletedBy are
+// set on the returned workflow.
+func (w *WorkflowService) GetDeletedWorkflow(ctx context.Context, workflowId string) (*WorkflowResponse, error) {
+	var resp WorkflowResponse
+	URL := w.client.config.BackendURL.JoinPath("workflows", workflowId)
+
+	v := URL.Query()
+	v.Set("deleted", "true")
+	URL.RawQuery = v.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, URL.String(), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = w.client.sendRequest(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+func (w *WorkflowService) GetWorkflows(ctx context.Context, page int, limit int) (*WorkflowListResponse, error) {
+	return w.SearchWorkflows(ctx, "", page, limit)
+}
+
+// GetWorkflowsCount returns the total number of workflows. It only requests a
+// single workflow, so it is cheaper than GetWorkflows when the items are not needed.
+func (w *WorkflowService) GetWorkflowsCount(ctx context.Context) (int, error) {
+	resp, err := w.GetWorkflows(ctx, 0, 1)
+	if err != nil {
+		return 0, err
+	}
+	return resp.TotalCount, nil
+}
+
+// GetWorkflowsPage lists a page of workflows. Pass NextCursor of the result as
+// opts.FetchNextPage to load the next page.
+func (w *WorkflowService) GetWorkflowsPage(ctx context.Context, opts PageOptions) (*PagedResult[Workflow], error) {
+	return fetchPage[Workflow](ctx, w.client, w.client.config.BackendURL.JoinPath("workflows"), opts)
+}
+
+// SearchWorkflows lists the workflows matching query. An empty query lists all
+// workflows like GetWorkflows.
+func (w *WorkflowService) SearchWorkflows(ctx context.Context, query string, page int, limit int) (*WorkflowListResponse, error) {
+	return w.GetWorkflowsFiltered(ctx, GetWorkflowsFilter{Query: query}, page, limit)
+}
+
+// GetWorkflowsByNotificationGroup lists the workflows of a notification group.
+func (w *WorkflowService) GetWorkflowsByNotificationGroup(ctx context.Context, notificationGroupId string, page int, limit int) (*WorkflowListResponse, error) {
+	return w.GetWorkflowsFiltered(ctx, GetWorkflowsFilter{NotificationGroupId: notificationGroupId}, page, limit)
+}
+
+// GetWorkflowGroups lists the groups workflows are organized in. It is an alias
+// for NotificationGroupService.GetNotificationGroups; group management lives on
+// NotificationGroupsApi, and WorkflowService only works with the workflows in a
+// group.
+func (w *WorkflowService) GetWorkflowGroups(ctx context.Context) (*NotificationGroupsResponse, error) {
+	return (*NotificationGroupService)(w).GetNotificationGroups(ctx)
+}
+
+// GetWorkflowsFiltered lists the workflows matching filter. Empty filter fields
+// are not sent.
+func (w *WorkflowService) GetWorkflowsFiltered(ctx context.Context, filter GetWorkflowsFilter, page int, limit int) (*WorkflowListResponse, error) {
+	var resp WorkflowListResponse
+	URL := w.client.config.BackendURL.JoinPath("workflows")
+
+	v := URL.Query()
+	v.Set("page", strconv.Itoa(page))
+	v.Set("limit", strconv.Itoa(limit))
+	if filter.Query != "" {
+		v.Set("query", filter.Query)
+	}
+	if filter.Channel != "" {
+		v.Set("channel", string(filter.Channel))
+	}
+	if filter.NotificationGroupId != "" {
+		v.Set("notificationGroup", filter.NotificationGroupId)
+	}
+	URL.RawQuery = v.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, URL.String(), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = w.client.sendRequest(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+func (w *WorkflowService) DeleteWorkflow(ctx context.Context, workflowId string) error {
+	var resp interface{}
+	URL := w.client.config.BackendURL.JoinPath("workflows", workflowId)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, URL.String(), http.NoBody)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.client.sendRequest(req, &resp)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (w *WorkflowService) UpdateWorkflowStatus(ctx context.Context, workflowId string, active bool) (*WorkflowResponse, error) {
+	var resp WorkflowResponse
+	URL := w.client.config.BackendURL.JoinPath("workflows", workflowId, "status")
+
+	jsonBody, _ := json.Marshal(UpdateWorkflowStatusRequest{Active: active})
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, URL.String(), bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = w.client.sendRequest(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// BulkUpdateWorkflowStatus activates or pauses every workflow in workflowIds
+// with up to 5 concurrent requests. A failed workflow does not stop the others;
+// it is reported in Errors, as are the workflows not attempted once ctx is done.
+func (w *WorkflowService) BulkUpdateWorkflowStatus(ctx context.Context, workflowIds []string, active bool) (*BulkUpdateResult, error) {
+	result := BulkUpdateResult{Updated: []string{}, Errors: []BulkUpdateError{}}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, bulkUpdateConcurrency)
+
+	for _, workflowId := range workflowIds {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			mu.Lock()
+			result.Errors = append(result.Errors, BulkUpdateError{WorkflowID: workflowId, Error: ctx.Err().Error()})
+			mu.Unlock()
+			continue
+		}
+
+		wg.Add(1)
+		go func(workflowId string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			_, err := w.UpdateWorkflowStatus(ctx, workflowId, active)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				result.Errors = append(result.Errors, BulkUpdateError{WorkflowID: workflowId, Error: err.Error()})
+				return
+			}
+			result.Updated = append(result.Updated, workflowId)
+		}(workflowId)
+	}
+	wg.Wait()
+
+	return &result, nil
+}
+
+// PauseWorkflow deactivates the workflow so triggering it sends nothing until
+// ResumeWorkflow is called.
+func (w *WorkflowService) PauseWorkflow(ctx context.Context, workflowId string) (*WorkflowResponse, error) {
+	return w.UpdateWorkflowStatus(ctx, workflowId, false)
+}
+
+// ResumeWorkflow reactivates a workflow paused with PauseWorkflow.
+func (w *WorkflowService) ResumeWorkflow(ctx context.Context, workflowId string) (*WorkflowResponse, error) {
+	return w.UpdateWorkflowStatus(ctx, workflowId, true)
+}
+
+// UpdateWorkflowNotificationGroup moves the workflow to another notification
+// group, leaving every other field untouched.
+func (w *WorkflowService) UpdateWorkflowNotificationGroup(ctx context.Context, workflowId string, notificationGroupId string) (*WorkflowResponse, error) {
+	return w.UpdateWorkflow(ctx, workflowId, UpdateWorkflowRequest{NotificationGroupId: notificationGroupId})
+}
+
+// UpdateWorkflowCriticalFlag sets whether the workflow is critical. Subscribers
+// cannot turn critical workflows off in their preferences.
+func (w *WorkflowService) UpdateWorkflowCriticalFlag(ctx context.Context, workflowId string, critical bool) (*WorkflowResponse, error) {
+	if workflowId == "" {
+		return nil, ErrMissingWorkflowId
+	}
+
+	return w.UpdateWorkflow(ctx, workflowId, UpdateWorkflowRequest{Critical: &critical})
+}
+
+// DuplicateWorkflow copies an existing workflow, including its steps, and
+// returns the newly created workflow.
+func (w *WorkflowService) DuplicateWorkflow(ctx context.Context, workflowId string) (*WorkflowResponse, error) {
+	var resp WorkflowResponse
+	URL := w.client.config.BackendURL.JoinPath("workflows", workflowId, "duplicate")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, URL.String(), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = w.client.sendRequest(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// TestSendWorkflow sends a test notification of the workflow to the given
+// addresses without creating a subscriber, e.g. to preview an email.
+func (w *WorkflowService) TestSendWorkflow(ctx context.Context, workflowId string, request TestSendRequest) (*TestSendResponse, error) {
+	var resp TestSendResponse
+	URL := w.client.config.BackendURL.JoinPath("workflows", workflowId, "test")
+
+	jsonBody, _ := json.Marshal(request)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, URL.String(), bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = w.client.sendRequest(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// CloneWorkflowToEnvironment copies the workflow into the environment target
+// is authenticated for, e.g. from a Development client to a Production client,
+// and returns the new workflow. API keys are scoped to one environment, so the
+// copy is created with target's key. Step and template ids of the source
+// environment are not copied, the notification group is matched by name and
+// created in target when missing, and the trigger identifier is kept. A
+// workflow with the same trigger identifier in target fails with
+// ErrWorkflowExists.
+func (w *WorkflowService) CloneWorkflowToEnvironment(ctx context.Context, workflowId string, target *APIClient) (*WorkflowResponse, error) {
+	if target == nil {
+		return nil, errors.New("target client is required")
+	}
+
+	source, err := w.GetWorkflow(ctx, workflowId)
+	if err != nil {
+		return nil, err
+	}
+
+	identifier := ""
+	if len(source.Data.Triggers) > 0 {
+		identifier = source.Data.Triggers[0].Identifier
+	}
+	if identifier != "" {
+		existing, err := target.WorkflowApi.findByTriggerIdentifier(ctx, identifier)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			return nil, errors.Wrapf(ErrWorkflowExists, "workflow %s in the target environment", identifier)
+		}
+	}
+
+	group, err := (*NotificationGroupService)(w).GetNotificationGroup(ctx, source.Data.NotificationGroupId)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get the notification group")
+	}
+	groupId, err := target.NotificationGroupsApi.findOrCreateByName(ctx, group.Data.Name)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find the notification group in the target environment")
+	}
+
+	created, err := target.WorkflowApi.CreateWorkflow(ctx, CreateWorkflowRequest{
+		Name:                source.Data.Name,
+		NotificationGroupId: groupId,
+		Tags:                source.Data.Tags,
+		Description:         source.Data.Description,
+		Steps:               withoutEnvironmentIds(source.Data.Steps),
+		Active:              source.Data.Active,
+		Draft:               source.Data.Draft,
+		Critical:            source.Data.Critical,
+		PreferenceSettings:  &source.Data.PreferenceSettings,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// the API derives the identifier from the name, which differs when the source was renamed
+	if identifier != "" && (len(created.Data.Triggers) == 0 || created.Data.Triggers[0].Identifier != identifier) {
+		return target.WorkflowApi.UpdateWorkflow(ctx, created.Data.Id, UpdateWorkflowRequest{Identifier: identifier})
+	}
+
+	return created, nil
+}
+
+// findByTriggerIdentifier returns the workflow triggered by identifier, or nil
+// when there is none.
+func (w *WorkflowService) findByTriggerIdentifier(ctx context.Context, identifier string) (*Workflow, error) {
+	for page, listed := 0, 0; ; page++ {
+		list, err := w.SearchWorkflows(ctx, identifier, page, searchPageSize)
+		if err != nil {
+			return nil, err
+		}
+		for i, workflow := range list.Data {
+			for _, trigger := range workflow.Triggers {
+				if trigger.Identifier == identifier {
+					return &list.Data[i], nil
+				}
+			}
+		}
+		listed += len(list.Data)
+		if len(list.Data) == 0 || listed >= list.TotalCount {
+			return nil, nil
+		}
+	}
+}
+
+// withoutEnvironmentIds copies steps without the ids that refer to documents of
+// their environment, such as _id, _templateId or the template's _layoutId.
+func withoutEnvironmentIds(steps []interface{}) []interface{} {
+	copied := make([]interface{}, 0, len(steps))
+	for _, step := range steps {
+		var fields map[string]interface{}
+		raw, _ := json.Marshal(step)
+		if err := json.Unmarshal(raw, &fields); err != nil {
+			copied = append(copied, step)
+			continue
+		}
+		deleteIdKeys(fields)
+		if template, ok := fields["template"].(map[string]interface{}); ok {
+			deleteIdKeys(template)
+		}
+		copied = append(copied, fields)
+	}
+	return copied
+}
+
+func deleteIdKeys(fields map[string]interface{}) {
+	for key := range fields {
+		if strings.HasPrefix(key, "_") {
+			delete(fields, key)
+		}
+	}
+}
+
+func (w *WorkflowService) GetWorkflowSteps(ctx context.Context, workflowId string) ([]WorkflowStep, error) {
+	var resp WorkflowStepsResponse
+	URL := w.client.config.BackendURL.JoinPath("workflows", workflowId, "steps")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, URL.String(), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = w.client.sendRequest(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Data, nil
+}
+
+func (w *WorkflowService) UpdateWorkflowStep(ctx context.Context, workflowId string, stepId string, request UpdateStepRequest) (*WorkflowStepResponse, error) {
+	var resp WorkflowStepResponse
+	URL := w.client.config.BackendURL.JoinPath("workflows", workflowId, "steps", stepId)
+
+	jsonBody, _ := json.Marshal(request)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, URL.String(), bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = w.client.sendRequest(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// PreviewWorkflowStep renders the step's template with data as the payload, so
+// templates can be checked without sending a notification.
+func (w *WorkflowService) PreviewWorkflowStep(ctx context.Context, workflowId string, stepId string, data map[string]interface{}) (*StepPreviewResponse, error) {
+	var resp StepPreviewResponse
+	URL := w.client.config.BackendURL.JoinPath("workflows", workflowId, "steps", stepId, "preview")
+
+	jsonBody, _ := json.Marshal(map[string]interface{}{"payload": data})
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, URL.String(), bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = w.client.sendRequest(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetWorkflowVariables returns the payload variables the workflow's step
+// templates expect, which can be used to validate trigger payloads.
+func (w *WorkflowService) GetWorkflowVariables(ctx context.Context, workflowId string) (*WorkflowVariablesResponse, error) {
+	var resp WorkflowVariablesResponse
+	URL := w.client.config.BackendURL.JoinPath("workflows", workflowId, "variables")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, URL.String(), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = w.client.sendRequest(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetWorkflowChangeHistory returns the workflow's changes on one page of the
+// environment's promoted or pending changes. The changes API cannot filter by
+// workflow, so the page is filtered here and may hold fewer changes than
+// opts.Limit, or none, while HasMore is still set; TotalCount is the number of
+// changes in the environment. Pages start at 0 and hold 100 changes by
+// default. Pass NextCursor as opts.FetchNextPage to scan the next page.
+func (w *WorkflowService) GetWorkflowChangeHistory(ctx context.Context, workflowId string, promoted bool, opts PageOptions) (*PagedResult[WorkflowChange], error) {
+	query := ChangesGetQuery{Page: opts.Page + 1, Limit: opts.Limit, Promoted: strconv.FormatBool(promoted)}
+	if query.Limit <= 0 {
+		query.Limit = changeHistoryPageSize
+	}
+	if opts.FetchNextPage != "" {
+		v, err := url.ParseQuery(opts.FetchNextPage)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid change history cursor")
+		}
+		page, pageErr := strconv.Atoi(v.Get("page"))
+		limit, limitErr := strconv.Atoi(v.Get("limit"))
+		if _, promotedErr := strconv.ParseBool(v.Get("promoted")); pageErr != nil || limitErr != nil || promotedErr != nil || page < 1 || limit < 1 {
+			return nil, errors.Errorf("invalid change history cursor %q", opts.FetchNextPage)
+		}
+		query.Page, query.Limit, query.Promoted = page, limit, v.Get("promoted")
+	}
+
+	resp, err := (*ChangesService)(w).GetChanges(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PagedResult[WorkflowChange]{Data: []WorkflowChange{}, TotalCount: resp.TotalCount}
+	for _, change := range resp.Data {
+		if change.EntityId != workflowId {
+			continue
+		}
+
+		createdAt, _ := time.Parse(time.RFC3339, change.CreatedAt)
+		result.Data = append(result.Data, WorkflowChange{
+			ChangeId:   change.Id,
+			CreatedBy:  UserRef{Id: change.CreatorId},
+			CreatedAt:  createdAt,
+			ChangeType: change.Type,
+			Diff:       change.Change,
+			Promoted:   query.Promoted == "true",
+		})
+	}
+
+	//changes pages start at 1
+	result.HasMore = len(resp.Data) > 0 && query.Page*query.Limit < resp.TotalCount
+	if result.HasMore {
+		next := query
+		next.Page++
+		result.NextCursor = next.BuildQuery()
+	}
+
+	return result, nil
+}
+
+func (w *WorkflowService) IterateWorkflows(limit int) *Paginator[Workflow] {
+	return NewPaginator(func(ctx context.Context, page int) ([]Workflow, int, error) {
+		resp, err := w.GetWorkflows(ctx, page, limit)
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Data, resp.TotalCount, nil
+	})
+}
+
+// GetWorkflowDisabledSubscribers returns the subscribers on one page of the
+// subscriber list that turned the workflow off. The API has no endpoint listing
+// opt-outs, so this costs one request for the page plus one preferences request
+// per subscriber on it, up to 5 at a time; keep limit small. page and limit
+// page through all subscribers, not the disabled ones: Page, PageSize,
+// TotalCount and HasMore describe the subscriber list, and Data only holds the
+// subscribers of the page that disabled the workflow, so it is often shorter
+// than limit or empty while more pages remain.
+func (w *WorkflowService) GetWorkflowDisabledSubscribers(ctx context.Context, workflowId string, page int, limit int) (*SubscriberListResponse, error) {
+	if workflowId == "" {
+		return nil, ErrMissingWorkflowId
+	}
+
+	subscribers := (*SubscriberService)(w)
+	list, err := subscribers.List(ctx, &SubscriberListOptions{Page: page, Limit: limit})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	disabled := make([]bool, len(list.Data))
+	var firstErr error
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, preferenceLookupConcurrency)
+
+	for i, subscriber := range list.Data {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, subscriberID string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			preferences, err := subscribers.GetPreferences(ctx, subscriberID)
+			if err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = errors.Wrapf(err, "failed to get preferences of subscriber %s", subscriberID)
+					cancel()
+				}
+				mu.Unlock()
+				return
+			}
+
+			for _, preference := range preferences.Data {
+				if preference.Template.ID == workflowId {
+					disabled[i] = !preference.Preference.Enabled
+					break
+				}
+			}
+		}(i, subscriber.SubscriberId)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	resp := *list
+	resp.Data = []Subscriber{}
+	for i, subscriber := range list.Data {
+		if disabled[i] {
+			resp.Data = append(resp.Data, subscriber)
+		}
+	}
+
+	return &resp, nil
+}
+
+// GetWorkflowChannelStats approximates per-channel message counts of the
+// workflow on the client. The API has no per-workflow analytics endpoint, so
+// this walks the activity feed of the workflow, newest first, and the messages
+// of each notification, about two requests per 100 notifications. The scan
+// stops after opts.MaxNotifications notifications and sets Truncated when more
+// matched; narrow it with opts.After and opts.Before.
+func (w *WorkflowService) GetWorkflowChannelStats(ctx context.Context, workflowId string, opts WorkflowChannelStatsOptions) (*WorkflowChannelStats, error) {
+	if workflowId == "" {
+		return nil, ErrMissingWorkflowId
+	}
+
+	maxNotifications := valueOrDefault(opts.MaxNotifications, defaultStatsMaxNotifications)
+	stats := WorkflowChannelStats{
+		Total:  map[ChannelType]int{},
+		Sent:   map[ChannelType]int{},
+		Failed: map[ChannelType]int{},
+		Seen:   map[ChannelType]int{},
+		Read:   map[ChannelType]int{},
+	}
+	notifications := (*NotificationService)(w)
+	messages := (*MessagesService)(w)
+
+	for page := 0; ; page++ {
+		activity, err := notifications.GetNotifications(ctx, GetNotificationsOptions{
+			Templates: []string{workflowId},
+			After:     opts.After,
+			Before:    opts.Before,
+			Page:      page,
+			Limit:     statsPageSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		data := activity.Data
+		if remaining := maxNotifications - stats.Notifications; len(data) > remaining {
+			data = data[:remaining]
+			stats.Truncated = true
+		}
+		stats.Notifications += len(data)
+
+		transactionIds := make([]string, 0, len(data))
+		for _, notification := range data {
+			transactionIds = append(transactionIds, notification.TransactionId)
+		}
+
+		for messagePage := 0; len(transactionIds) > 0; messagePage++ {
+			list, err := messages.GetMessages(ctx, MessagesQueryParams{TransactionId: transactionIds, Page: messagePage, Limit: statsPageSize})
+			if err != nil {
+				return nil, err
+			}
+			for _, message := range list.Data {
+				if message.TemplateId != workflowId {
+					continue
+				}
+				stats.Total[message.Channel]++
+				switch message.Status {
+				case "sent":
+					stats.Sent[message.Channel]++
+				case "error":
+					stats.Failed[message.Channel]++
+				}
+				if message.Seen {
+					stats.Seen[message.Channel]++
+				}
+				if message.Read {
+					stats.Read[message.Channel]++
+				}
+			}
+			if !list.HasMore || len(list.Data) == 0 {
+				break
+			}
+		}
+
+		if !activity.HasMore || len(activity.Data) == 0 {
+			return &stats, nil
+		}
+		if stats.Notifications >= maxNotifications {
+			stats.Truncated = true
+			return &stats, nil
+		}
+	}
+}
+
+var _ IWorkflow = &WorkflowService{}
@@ -0,0 +1,148 @@
+package lib
+
+import (
+	"github.com/pkg/errors"
+)
+
+// MessageStep is a channel step added by WorkflowBuilder. It can also be passed
+// in CreateWorkflowRequest.Steps directly.
+type MessageStep struct {
+	Name     string       `json:"name,omitempty"`
+	Active   bool         `json:"active"`
+	Filters  []StepFilter `json:"filters,omitempty"`
+	Template StepTemplate `json:"template"`
+}
+
+type DelayMetadata struct {
+	Type   string     `json:"type"`
+	Amount int        `json:"amount"`
+	Unit   DigestUnit `json:"unit"`
+}
+
+// DelayStep is a workflow step that holds the rest of the workflow for a fixed
+// amount of time.
+type DelayStep struct {
+	Name     string        `json:"name,omitempty"`
+	Active   bool          `json:"active"`
+	Template StepTemplate  `json:"template"`
+	Metadata DelayMetadata `json:"metadata"`
+}
+
+// WorkflowBuilder builds a CreateWorkflowRequest step by step. Steps are added
+// in the order they run, and the first error from a step is returned by Build.
+type WorkflowBuilder struct {
+	request CreateWorkflowRequest
+	err     error
+}
+
+func NewWorkflowBuilder(name string) *WorkflowBuilder {
+	return &WorkflowBuilder{request: CreateWorkflowRequest{Name: name}}
+}
+
+func (b *WorkflowBuilder) SetDescription(description string) *WorkflowBuilder {
+	b.request.Description = description
+	return b
+}
+
+func (b *WorkflowBuilder) SetActive(active bool) *WorkflowBuilder {
+	b.request.Active = active
+	return b
+}
+
+func (b *WorkflowBuilder) SetCritical(critical bool) *WorkflowBuilder {
+	b.request.Critical = critical
+	return b
+}
+
+func (b *WorkflowBuilder) SetNotificationGroupId(notificationGroupId string) *WorkflowBuilder {
+	b.request.NotificationGroupId = notificationGroupId
+	return b
+}
+
+func (b *WorkflowBuilder) SetPreferenceSettings(settings Channel) *WorkflowBuilder {
+	b.request.PreferenceSettings = &settings
+	return b
+}
+
+// AddEmailStep adds an email step whose content is sent as custom HTML.
+func (b *WorkflowBuilder) AddEmailStep(name string, subject string, content string, filters ...StepFilter) *WorkflowBuilder {
+	return b.addMessageStep(name, filters, StepTemplate{
+		Type:        StepTypeEmail,
+		Subject:     subject,
+		Content:     content,
+		ContentType: "customHtml",
+	})
+}
+
+func (b *WorkflowBuilder) AddSMSStep(name string, content string, filters ...StepFilter) *WorkflowBuilder {
+	return b.addMessageStep(name, filters, StepTemplate{Type: StepTypeSMS, Content: content})
+}
+
+func (b *WorkflowBuilder) AddInAppStep(name string, content string, filters ...StepFilter) *WorkflowBuilder {
+	return b.addMessageStep(name, filters, StepTemplate{Type: StepTypeInApp, Content: content})
+}
+
+func (b *WorkflowBuilder) AddPushStep(name string, title string, content string, filters ...StepFilter) *WorkflowBuilder {
+	return b.addMessageStep(name, filters, StepTemplate{Type: StepTypePush, Title: title, Content: content})
+}
+
+// AddDigestStep builds the digest step and adds it to the workflow.
+func (b *WorkflowBuilder) AddDigestStep(digest *DigestStepBuilder) *WorkflowBuilder {
+	step, err := digest.Build()
+	if err != nil {
+		b.setErr(err)
+		return b
+	}
+	b.request.Steps = append(b.request.Steps, step)
+	return b
+}
+
+// AddDelayStep adds a step that waits for amount units before the next step runs.
+func (b *WorkflowBuilder) AddDelayStep(name string, amount int, unit DigestUnit) *WorkflowBuilder {
+	if amount <= 0 {
+		b.setErr(errors.New("delay amount must be greater than zero"))
+		return b
+	}
+	if !unit.valid() {
+		b.setErr(errors.Errorf("unsupported delay unit %q", unit))
+		return b
+	}
+	b.request.Steps = append(b.request.Steps, DelayStep{
+		Name:     name,
+		Active:   true,
+		Template: StepTemplate{Type: StepTypeDelay},
+		Metadata: DelayMetadata{Type: "regular", Amount: amount, Unit: unit},
+	})
+	return b
+}
+
+// Build validates the request and returns it. Later changes to the builder do
+// not affect a request that was already built.
+func (b *WorkflowBuilder) Build() (CreateWorkflowRequest, error) {
+	if b.err != nil {
+		return CreateWorkflowRequest{}, b.err
+	}
+
+	request := b.request
+	request.Steps = append([]interface{}(nil), b.request.Steps...)
+	if err := request.Validate(); err != nil {
+		return CreateWorkflowRequest{}, err
+	}
+	return request, nil
+}
+
+func (b *WorkflowBuilder) addMessageStep(name string, filters []StepFilter, template StepTemplate) *WorkflowBuilder {
+	b.request.Steps = append(b.request.Steps, MessageStep{
+		Name:     name,
+		Active:   true,
+		Filters:  filters,
+		Template: template,
+	})
+	return b
+}
+
+func (b *WorkflowBuilder) setErr(err error) {
+	if b.err == nil {
+		b.err = err
+	}
+}
@@ -0,0 +1,82 @@
+package lib_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/novuhq/go-novu/lib"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkflowBuilder_Build(t *testing.T) {
+	hasPhone := lib.StepFilter{
+		Type:     "GROUP",
+		Value:    "AND",
+		Children: []lib.FilterPart{{On: lib.FilterOnSubscriber, Field: "phone", Operator: lib.FilterOperatorIsDefined}},
+	}
+
+	request, err := lib.NewWorkflowBuilder("welcome").
+		SetDescription("Welcome new users").
+		SetNotificationGroupId("groupId").
+		SetActive(true).
+		SetPreferenceSettings(lib.Channel{Email: true, InApp: true}).
+		AddInAppStep("in-app", "Welcome {{name}}").
+		AddDelayStep("wait", 1, lib.DigestUnitDays).
+		AddDigestStep(lib.NewDigestStepBuilder().SetAmount(5).SetUnit(lib.DigestUnitMinutes)).
+		AddEmailStep("email", "Welcome", "<p>Hello {{name}}</p>").
+		AddSMSStep("sms", "Welcome {{name}}", hasPhone).
+		AddPushStep("push", "Welcome", "Hello {{name}}").
+		Build()
+
+	require.NoError(t, err)
+
+	body, _ := json.Marshal(request)
+	assert.JSONEq(t, `{
+		"name": "welcome",
+		"notificationGroupId": "groupId",
+		"description": "Welcome new users",
+		"active": true,
+		"preferenceSettings": {"email": true, "sms": false, "chat": false, "in_app": true, "push": false},
+		"steps": [
+			{"name": "in-app", "active": true, "template": {"type": "in_app", "content": "Welcome {{name}}"}},
+			{"name": "wait", "active": true, "template": {"type": "delay"}, "metadata": {"type": "regular", "amount": 1, "unit": "days"}},
+			{"active": true, "template": {"type": "digest"}, "metadata": {"type": "regular", "amount": 5, "unit": "minutes"}},
+			{"name": "email", "active": true, "template": {"type": "email", "subject": "Welcome", "content": "<p>Hello {{name}}</p>", "contentType": "customHtml"}},
+			{"name": "sms", "active": true, "template": {"type": "sms", "content": "Welcome {{name}}"}, "filters": [
+				{"isNegated": false, "type": "GROUP", "value": "AND", "children": [{"on": "subscriber", "field": "phone", "operator": "IS_DEFINED"}]}
+			]},
+			{"name": "push", "active": true, "template": {"type": "push", "title": "Welcome", "content": "Hello {{name}}"}}
+		]
+	}`, string(body))
+}
+
+func TestWorkflowBuilder_Validation(t *testing.T) {
+	tests := map[string]struct {
+		builder *lib.WorkflowBuilder
+		wantErr string
+	}{
+		"missing group": {
+			builder: lib.NewWorkflowBuilder("welcome").AddInAppStep("in-app", "Hello"),
+			wantErr: "workflow notification group id is required",
+		},
+		"missing steps": {
+			builder: lib.NewWorkflowBuilder("welcome").SetNotificationGroupId("groupId"),
+			wantErr: "workflow requires at least one step",
+		},
+		"invalid digest": {
+			builder: lib.NewWorkflowBuilder("welcome").SetNotificationGroupId("groupId").AddDigestStep(lib.NewDigestStepBuilder()),
+			wantErr: "digest amount must be greater than zero",
+		},
+		"invalid delay": {
+			builder: lib.NewWorkflowBuilder("welcome").SetNotificationGroupId("groupId").AddDelayStep("wait", 0, lib.DigestUnitDays),
+			wantErr: "delay amount must be greater than zero",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := tt.builder.Build()
+			require.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
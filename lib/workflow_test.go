@@ -0,0 +1,924 @@
+package lib_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/novuhq/go-novu/lib"
+	"github.com/stretchr/testify/require"
+)
+
+const workflowId = "6425cb064a1ad8b3b5b30ef8"
+
+var workflowResponse = lib.WorkflowResponse{
+	Data: lib.Workflow{
+		Id:                  workflowId,
+		Name:                "workflow",
+		Description:         "description",
+		Active:              true,
+		Tags:                []string{"tag"},
+		Steps:               []interface{}{},
+		NotificationGroupId: "groupId",
+		Triggers: []lib.WorkflowTrigger{{
+			Type:       "event",
+			Identifier: "workflow",
+			Variables:  []lib.WorkflowTriggerVariable{{Name: "name"}},
+		}},
+		CreatedAt: "2023-03-30T17:52:06.471Z",
+		UpdatedAt: "2023-03-30T17:52:06.471Z",
+	},
+}
+
+func TestWorkflowService_CreateWorkflow_Success(t *testing.T) {
+	createWorkflowRequest := lib.CreateWorkflowRequest{
+		Name:                "workflow",
+		NotificationGroupId: "groupId",
+		Tags:                []string{"tag"},
+		Description:         "description",
+		Steps:               []interface{}{map[string]interface{}{"template": map[string]interface{}{"type": "in_app", "content": "Hello"}}},
+		Active:              true,
+	}
+
+	httpServer := createTestServer(t, TestServerOptions[lib.CreateWorkflowRequest, lib.WorkflowResponse]{
+		expectedURLPath:    "/v1/workflows",
+		expectedSentMethod: http.MethodPost,
+		expectedSentBody:   createWorkflowRequest,
+		responseStatusCode: http.StatusCreated,
+		responseBody:       workflowResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.WorkflowApi.CreateWorkflow(ctx, createWorkflowRequest)
+
+	require.NoError(t, err)
+	require.Equal(t, &workflowResponse, resp)
+}
+
+func TestWorkflowService_CreateWorkflow_WithStepFilters(t *testing.T) {
+	createWorkflowRequest := lib.CreateWorkflowRequest{
+		Name:                "workflow",
+		NotificationGroupId: "groupId",
+		Steps: []interface{}{lib.WorkflowStep{
+			Active: true,
+			Filters: []lib.StepFilter{{
+				Type:  "GROUP",
+				Value: "AND",
+				Children: []lib.FilterPart{
+					{On: lib.FilterOnSubscriber, Field: "phone", Operator: lib.FilterOperatorIsDefined},
+					{On: lib.FilterOnPayload, Field: "plan", Value: "pro", Operator: lib.FilterOperatorEqual},
+				},
+			}},
+			Template: lib.StepTemplate{Type: lib.StepTypeSMS, Content: "Hello"},
+		}},
+	}
+
+	httpServer := createTestServer(t, TestServerOptions[map[string]interface{}, lib.WorkflowResponse]{
+		expectedURLPath:    "/v1/workflows",
+		expectedSentMethod: http.MethodPost,
+		expectedSentBody: map[string]interface{}{
+			"name":                "workflow",
+			"notificationGroupId": "groupId",
+			"steps": []interface{}{map[string]interface{}{
+				"active":           true,
+				"shouldStopOnFail": false,
+				"filters": []interface{}{map[string]interface{}{
+					"isNegated": false,
+					"type":      "GROUP",
+					"value":     "AND",
+					"children": []interface{}{
+						map[string]interface{}{"on": "subscriber", "field": "phone", "operator": "IS_DEFINED"},
+						map[string]interface{}{"on": "payload", "field": "plan", "value": "pro", "operator": "EQUAL"},
+					},
+				}},
+				"template": map[string]interface{}{"type": "sms", "content": "Hello"},
+			}},
+		},
+		responseStatusCode: http.StatusCreated,
+		responseBody:       workflowResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	_, err := c.WorkflowApi.CreateWorkflow(ctx, createWorkflowRequest)
+
+	require.NoError(t, err)
+
+	var sent map[string]interface{}
+	step, _ := json.Marshal(createWorkflowRequest.Steps[0])
+	require.NoError(t, json.Unmarshal(step, &sent))
+	require.NotContains(t, sent, "_id", "a new step must not send an empty step id")
+	require.NotContains(t, sent, "_templateId", "a new step must not send an empty template id")
+}
+
+func TestCreateWorkflowRequest_Validate(t *testing.T) {
+	steps := []interface{}{map[string]interface{}{"template": map[string]interface{}{"type": "in_app"}}}
+	tests := map[string]struct {
+		request lib.CreateWorkflowRequest
+		wantErr string
+	}{
+		"valid":                {request: lib.CreateWorkflowRequest{Name: "workflow", NotificationGroupId: "groupId", Steps: steps}},
+		"missing name":         {request: lib.CreateWorkflowRequest{NotificationGroupId: "groupId", Steps: steps}, wantErr: "workflow name is required"},
+		"missing group":        {request: lib.CreateWorkflowRequest{Name: "workflow", Steps: steps}, wantErr: "workflow notification group id is required"},
+		"missing steps":        {request: lib.CreateWorkflowRequest{Name: "workflow", NotificationGroupId: "groupId"}, wantErr: "workflow requires at least one step"},
+		"empty steps rejected": {request: lib.CreateWorkflowRequest{Name: "workflow", NotificationGroupId: "groupId", Steps: []interface{}{}}, wantErr: "workflow requires at least one step"},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tt.request.Validate()
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestWorkflowService_CreateWorkflow_InvalidRequest(t *testing.T) {
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL("http://127.0.0.1:0")})
+	resp, err := c.WorkflowApi.CreateWorkflow(context.Background(), lib.CreateWorkflowRequest{Name: "workflow"})
+
+	require.EqualError(t, err, "workflow notification group id is required")
+	require.Nil(t, resp)
+}
+
+func TestWorkflowService_UpdateWorkflow_Success(t *testing.T) {
+	updateWorkflowRequest := lib.UpdateWorkflowRequest{
+		Name:        "workflow",
+		Description: "description",
+	}
+
+	httpServer := createTestServer(t, TestServerOptions[lib.UpdateWorkflowRequest, lib.WorkflowResponse]{
+		expectedURLPath:    fmt.Sprintf("/v1/workflows/%s", workflowId),
+		expectedSentMethod: http.MethodPut,
+		expectedSentBody:   updateWorkflowRequest,
+		responseStatusCode: http.StatusOK,
+		responseBody:       workflowResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.WorkflowApi.UpdateWorkflow(ctx, workflowId, updateWorkflowRequest)
+
+	require.NoError(t, err)
+	require.Equal(t, &workflowResponse, resp)
+}
+
+func TestWorkflowService_GetWorkflow_Success(t *testing.T) {
+	httpServer := createTestServer(t, TestServerOptions[io.Reader, lib.WorkflowResponse]{
+		expectedURLPath:    fmt.Sprintf("/v1/workflows/%s", workflowId),
+		expectedSentMethod: http.MethodGet,
+		expectedSentBody:   http.NoBody,
+		responseStatusCode: http.StatusOK,
+		responseBody:       workflowResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.WorkflowApi.GetWorkflow(ctx, workflowId)
+
+	require.NoError(t, err)
+	require.Equal(t, &workflowResponse, resp)
+}
+
+func TestWorkflowService_GetDeletedWorkflow_Success(t *testing.T) {
+	deletedResponse := workflowResponse
+	deletedResponse.Data.Deleted = true
+	deletedResponse.Data.DeletedAt = "2023-08-01T10:00:00.000Z"
+
+	httpServer := createTestServer(t, TestServerOptions[io.Reader, lib.WorkflowResponse]{
+		expectedURLPath:    fmt.Sprintf("/v1/workflows/%s?deleted=true", workflowId),
+		expectedSentMethod: http.MethodGet,
+		expectedSentBody:   http.NoBody,
+		responseStatusCode: http.StatusOK,
+		responseBody:       deletedResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.WorkflowApi.GetDeletedWorkflow(ctx, workflowId)
+
+	require.NoError(t, err)
+	require.Equal(t, &deletedResponse, resp)
+	require.True(t, resp.Data.Deleted)
+}
+
+func TestWorkflowService_GetWorkflows_Success(t *testing.T) {
+	expectedResponse := lib.WorkflowListResponse{
+		Page:       1,
+		PageSize:   10,
+		TotalCount: 1,
+		Data:       []lib.Workflow{workflowResponse.Data},
+	}
+
+	httpServer := createTestServer(t, TestServerOptions[io.Reader, lib.WorkflowListResponse]{
+		expectedURLPath:    "/v1/workflows?limit=10&page=1",
+		expectedSentMethod: http.MethodGet,
+		expectedSentBody:   http.NoBody,
+		responseStatusCode: http.StatusOK,
+		responseBody:       expectedResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.WorkflowApi.GetWorkflows(ctx, 1, 10)
+
+	require.NoError(t, err)
+	require.Equal(t, &expectedResponse, resp)
+}
+
+func TestWorkflowService_DeleteWorkflow_Success(t *testing.T) {
+	httpServer := createTestServer(t, TestServerOptions[io.Reader, map[string]bool]{
+		expectedURLPath:    fmt.Sprintf("/v1/workflows/%s", workflowId),
+		expectedSentMethod: http.MethodDelete,
+		expectedSentBody:   http.NoBody,
+		responseStatusCode: http.StatusOK,
+		responseBody:       map[string]bool{"data": true},
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	err := c.WorkflowApi.DeleteWorkflow(ctx, workflowId)
+
+	require.NoError(t, err)
+}
+
+func TestWorkflowService_UpdateWorkflowStatus_Success(t *testing.T) {
+	httpServer := createTestServer(t, TestServerOptions[lib.UpdateWorkflowStatusRequest, lib.WorkflowResponse]{
+		expectedURLPath:    fmt.Sprintf("/v1/workflows/%s/status", workflowId),
+		expectedSentMethod: http.MethodPut,
+		expectedSentBody:   lib.UpdateWorkflowStatusRequest{Active: true},
+		responseStatusCode: http.StatusOK,
+		responseBody:       workflowResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.WorkflowApi.UpdateWorkflowStatus(ctx, workflowId, true)
+
+	require.NoError(t, err)
+	require.Equal(t, &workflowResponse, resp)
+}
+
+func TestWorkflowService_PauseWorkflow_Success(t *testing.T) {
+	httpServer := createTestServer(t, TestServerOptions[map[string]interface{}, lib.WorkflowResponse]{
+		expectedURLPath:    fmt.Sprintf("/v1/workflows/%s/status", workflowId),
+		expectedSentMethod: http.MethodPut,
+		expectedSentBody:   map[string]interface{}{"active": false},
+		responseStatusCode: http.StatusOK,
+		responseBody:       workflowResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.WorkflowApi.PauseWorkflow(ctx, workflowId)
+
+	require.NoError(t, err)
+	require.Equal(t, &workflowResponse, resp)
+}
+
+func TestWorkflowService_ResumeWorkflow_Success(t *testing.T) {
+	httpServer := createTestServer(t, TestServerOptions[lib.UpdateWorkflowStatusRequest, lib.WorkflowResponse]{
+		expectedURLPath:    fmt.Sprintf("/v1/workflows/%s/status", workflowId),
+		expectedSentMethod: http.MethodPut,
+		expectedSentBody:   lib.UpdateWorkflowStatusRequest{Active: true},
+		responseStatusCode: http.StatusOK,
+		responseBody:       workflowResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.WorkflowApi.ResumeWorkflow(ctx, workflowId)
+
+	require.NoError(t, err)
+	require.Equal(t, &workflowResponse, resp)
+}
+
+func TestWorkflowService_DuplicateWorkflow_Success(t *testing.T) {
+	duplicated := workflowResponse
+	duplicated.Data.Id = "6425cb064a1ad8b3b5b30ef9"
+	duplicated.Data.Name = "workflow (Copy)"
+
+	httpServer := createTestServer(t, TestServerOptions[io.Reader, lib.WorkflowResponse]{
+		expectedURLPath:    fmt.Sprintf("/v1/workflows/%s/duplicate", workflowId),
+		expectedSentMethod: http.MethodPost,
+		expectedSentBody:   http.NoBody,
+		responseStatusCode: http.StatusCreated,
+		responseBody:       duplicated,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.WorkflowApi.DuplicateWorkflow(ctx, workflowId)
+
+	require.NoError(t, err)
+	require.Equal(t, &duplicated, resp)
+	require.NotEqual(t, workflowId, resp.Data.Id)
+}
+
+func TestWorkflowService_TestSendWorkflow_Success(t *testing.T) {
+	request := lib.TestSendRequest{
+		To:      map[string]string{"email": "dev@example.com"},
+		Payload: map[string]interface{}{"name": "John"},
+		StepId:  "stepId",
+	}
+	expectedResponse := lib.TestSendResponse{
+		Data: lib.EventResponseData{Acknowledged: true, Status: "processed", TransactionId: "transactionId"},
+	}
+
+	httpServer := createTestServer(t, TestServerOptions[lib.TestSendRequest, lib.TestSendResponse]{
+		expectedURLPath:    fmt.Sprintf("/v1/workflows/%s/test", workflowId),
+		expectedSentMethod: http.MethodPost,
+		expectedSentBody:   request,
+		responseStatusCode: http.StatusCreated,
+		responseBody:       expectedResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.WorkflowApi.TestSendWorkflow(ctx, workflowId, request)
+
+	require.NoError(t, err)
+	require.Equal(t, &expectedResponse, resp)
+}
+
+func TestWorkflowService_CloneWorkflowToEnvironment_Success(t *testing.T) {
+	source := workflowResponse
+	source.Data.Name = "renamed workflow"
+	source.Data.Steps = []interface{}{map[string]interface{}{
+		"_id":         "stepId",
+		"_templateId": "templateId",
+		"active":      true,
+		"template":    map[string]interface{}{"_id": "templateId", "_layoutId": "layoutId", "type": "in_app", "content": "Hello"},
+	}}
+
+	sourceServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		require.Equal(t, "ApiKey "+novuApiKey, req.Header.Get("Authorization"))
+		switch req.URL.Path {
+		case "/v1/workflows/" + workflowId:
+			json.NewEncoder(w).Encode(source)
+		case "/v1/notification-groups/groupId":
+			w.Write([]byte(`{"data":{"_id":"groupId","name":"General"}}`))
+		default:
+			t.Errorf("unexpected request to the source environment %s %s", req.Method, req.URL.Path)
+		}
+	}))
+	defer sourceServer.Close()
+
+	clonedResponse := source
+	clonedResponse.Data.Id = "clonedWorkflowId"
+	clonedResponse.Data.NotificationGroupId = "productionGroupId"
+	var requests []string
+	targetServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		require.Equal(t, "ApiKey productionApiKey", req.Header.Get("Authorization"))
+		requests = append(requests, req.Method+" "+req.URL.Path)
+		switch req.Method + " " + req.URL.Path {
+		case "GET /v1/workflows":
+			require.Equal(t, "workflow", req.URL.Query().Get("query"))
+			w.Write([]byte(`{"totalCount":0,"data":[]}`))
+		case "GET /v1/notification-groups":
+			w.Write([]byte(`{"data":[{"_id":"productionGroupId","name":"General"}]}`))
+		case "POST /v1/workflows":
+			var body map[string]interface{}
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+			require.Equal(t, "productionGroupId", body["notificationGroupId"])
+			require.Equal(t, []interface{}{map[string]interface{}{
+				"active":   true,
+				"template": map[string]interface{}{"type": "in_app", "content": "Hello"},
+			}}, body["steps"])
+
+			created := clonedResponse
+			created.Data.Triggers = []lib.WorkflowTrigger{{Type: "event", Identifier: "renamed-workflow"}}
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(created)
+		case "PUT /v1/workflows/clonedWorkflowId":
+			var body lib.UpdateWorkflowRequest
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+			require.Equal(t, lib.UpdateWorkflowRequest{Identifier: "workflow"}, body)
+			json.NewEncoder(w).Encode(clonedResponse)
+		default:
+			t.Errorf("unexpected request to the target environment %s %s", req.Method, req.URL.Path)
+		}
+	}))
+	defer targetServer.Close()
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(sourceServer.URL)})
+	target := lib.NewAPIClient("productionApiKey", &lib.Config{BackendURL: lib.MustParseURL(targetServer.URL)})
+	resp, err := c.WorkflowApi.CloneWorkflowToEnvironment(ctx, workflowId, target)
+
+	require.NoError(t, err)
+	require.Equal(t, &clonedResponse, resp)
+	require.Equal(t, []string{
+		"GET /v1/workflows",
+		"GET /v1/notification-groups",
+		"POST /v1/workflows",
+		"PUT /v1/workflows/clonedWorkflowId",
+	}, requests)
+}
+
+func TestWorkflowService_CloneWorkflowToEnvironment_Exists(t *testing.T) {
+	sourceServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		json.NewEncoder(w).Encode(workflowResponse)
+	}))
+	defer sourceServer.Close()
+
+	targetServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		require.Equal(t, "GET /v1/workflows", req.Method+" "+req.URL.Path)
+		json.NewEncoder(w).Encode(lib.WorkflowListResponse{TotalCount: 1, Data: []lib.Workflow{workflowResponse.Data}})
+	}))
+	defer targetServer.Close()
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(sourceServer.URL)})
+	target := lib.NewAPIClient("productionApiKey", &lib.Config{BackendURL: lib.MustParseURL(targetServer.URL)})
+	_, err := c.WorkflowApi.CloneWorkflowToEnvironment(ctx, workflowId, target)
+
+	require.ErrorIs(t, err, lib.ErrWorkflowExists)
+}
+
+func TestWorkflowService_GetWorkflowSteps_Success(t *testing.T) {
+	steps := lib.WorkflowStepsResponse{
+		Data: []lib.WorkflowStep{
+			{
+				StepId:     "stepId",
+				TemplateId: "templateId",
+				Name:       "Welcome email",
+				Type:       lib.StepTypeEmail,
+				Active:     true,
+				Template: lib.StepTemplate{
+					Id:      "templateId",
+					Type:    lib.StepTypeEmail,
+					Subject: "Welcome {{name}}",
+					Content: "Hello",
+				},
+			},
+			{
+				StepId:   "digestStepId",
+				Type:     lib.StepTypeDigest,
+				Active:   true,
+				Template: lib.StepTemplate{Type: lib.StepTypeDigest},
+			},
+		},
+	}
+
+	httpServer := createTestServer(t, TestServerOptions[io.Reader, lib.WorkflowStepsResponse]{
+		expectedURLPath:    fmt.Sprintf("/v1/workflows/%s/steps", workflowId),
+		expectedSentMethod: http.MethodGet,
+		expectedSentBody:   http.NoBody,
+		responseStatusCode: http.StatusOK,
+		responseBody:       steps,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.WorkflowApi.GetWorkflowSteps(ctx, workflowId)
+
+	require.NoError(t, err)
+	require.Equal(t, steps.Data, resp)
+}
+
+func TestWorkflowService_UpdateWorkflowStep_Success(t *testing.T) {
+	active := false
+	updateStepRequest := lib.UpdateStepRequest{
+		Active:        &active,
+		ReplyCallback: &lib.StepReplyCallback{Active: true, Url: "https://example.com/reply"},
+	}
+	expectedResponse := lib.WorkflowStepResponse{
+		Data: lib.WorkflowStep{
+			StepId:        "stepId",
+			Type:          lib.StepTypeEmail,
+			ReplyCallback: updateStepRequest.ReplyCallback,
+			Template:      lib.StepTemplate{Type: lib.StepTypeEmail},
+		},
+	}
+
+	httpServer := createTestServer(t, TestServerOptions[map[string]interface{}, lib.WorkflowStepResponse]{
+		expectedURLPath:    fmt.Sprintf("/v1/workflows/%s/steps/stepId", workflowId),
+		expectedSentMethod: http.MethodPut,
+		expectedSentBody: map[string]interface{}{
+			"active":        false,
+			"replyCallback": map[string]interface{}{"active": true, "url": "https://example.com/reply"},
+		},
+		responseStatusCode: http.StatusOK,
+		responseBody:       expectedResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.WorkflowApi.UpdateWorkflowStep(ctx, workflowId, "stepId", updateStepRequest)
+
+	require.NoError(t, err)
+	require.Equal(t, &expectedResponse, resp)
+}
+
+func TestWorkflowService_PreviewWorkflowStep_Success(t *testing.T) {
+	expectedResponse := lib.StepPreviewResponse{
+		Data: lib.StepPreview{
+			Subject:  "Welcome Jane",
+			Content:  "<p>Hello Jane, your plan is </p>",
+			Warnings: []string{"plan is not defined in the payload"},
+		},
+	}
+
+	httpServer := createTestServer(t, TestServerOptions[map[string]interface{}, lib.StepPreviewResponse]{
+		expectedURLPath:    fmt.Sprintf("/v1/workflows/%s/steps/stepId/preview", workflowId),
+		expectedSentMethod: http.MethodPost,
+		expectedSentBody: map[string]interface{}{
+			"payload": map[string]interface{}{"name": "Jane"},
+		},
+		responseStatusCode: http.StatusOK,
+		responseBody:       expectedResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.WorkflowApi.PreviewWorkflowStep(ctx, workflowId, "stepId", map[string]interface{}{"name": "Jane"})
+
+	require.NoError(t, err)
+	require.Equal(t, &expectedResponse, resp)
+}
+
+func TestWorkflowService_GetWorkflowVariables_Success(t *testing.T) {
+	expectedResponse := lib.WorkflowVariablesResponse{
+		Data: lib.WorkflowVariables{
+			Variables: []lib.WorkflowVariable{
+				{Name: "firstName", Type: "String", Required: true},
+				{Name: "plan", Type: "String", DefaultValue: "free"},
+			},
+		},
+	}
+
+	httpServer := createTestServer(t, TestServerOptions[io.Reader, lib.WorkflowVariablesResponse]{
+		expectedURLPath:    fmt.Sprintf("/v1/workflows/%s/variables", workflowId),
+		expectedSentMethod: http.MethodGet,
+		expectedSentBody:   http.NoBody,
+		responseStatusCode: http.StatusOK,
+		responseBody:       expectedResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.WorkflowApi.GetWorkflowVariables(ctx, workflowId)
+
+	require.NoError(t, err)
+	require.Equal(t, &expectedResponse, resp)
+}
+
+func TestWorkflowService_SearchWorkflows_Success(t *testing.T) {
+	expectedResponse := lib.WorkflowListResponse{
+		Page:       0,
+		PageSize:   10,
+		TotalCount: 1,
+		Data:       []lib.Workflow{workflowResponse.Data},
+	}
+
+	httpServer := createTestServer(t, TestServerOptions[io.Reader, lib.WorkflowListResponse]{
+		expectedURLPath:    "/v1/workflows?limit=10&page=0&query=welcome+email",
+		expectedSentMethod: http.MethodGet,
+		expectedSentBody:   http.NoBody,
+		responseStatusCode: http.StatusOK,
+		responseBody:       expectedResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.WorkflowApi.SearchWorkflows(ctx, "welcome email", 0, 10)
+
+	require.NoError(t, err)
+	require.Equal(t, &expectedResponse, resp)
+}
+
+func TestWorkflowService_UpdateWorkflowCriticalFlag_Success(t *testing.T) {
+	httpServer := createTestServer(t, TestServerOptions[map[string]interface{}, lib.WorkflowResponse]{
+		expectedURLPath:    fmt.Sprintf("/v1/workflows/%s", workflowId),
+		expectedSentMethod: http.MethodPut,
+		expectedSentBody:   map[string]interface{}{"critical": false},
+		responseStatusCode: http.StatusOK,
+		responseBody:       workflowResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.WorkflowApi.UpdateWorkflowCriticalFlag(ctx, workflowId, false)
+
+	require.NoError(t, err)
+	require.Equal(t, &workflowResponse, resp)
+}
+
+func TestWorkflowService_UpdateWorkflowCriticalFlag_MissingId(t *testing.T) {
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL("http://127.0.0.1:0")})
+	resp, err := c.WorkflowApi.UpdateWorkflowCriticalFlag(context.Background(), "", true)
+
+	require.ErrorIs(t, err, lib.ErrMissingWorkflowId)
+	require.Nil(t, resp)
+}
+
+func TestWorkflowService_GetWorkflowsFiltered_Success(t *testing.T) {
+	expectedResponse := lib.WorkflowListResponse{
+		Page:       1,
+		PageSize:   10,
+		TotalCount: 1,
+		Data:       []lib.Workflow{workflowResponse.Data},
+	}
+
+	httpServer := createTestServer(t, TestServerOptions[io.Reader, lib.WorkflowListResponse]{
+		expectedURLPath:    "/v1/workflows?channel=email&limit=10&page=1",
+		expectedSentMethod: http.MethodGet,
+		expectedSentBody:   http.NoBody,
+		responseStatusCode: http.StatusOK,
+		responseBody:       expectedResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.WorkflowApi.GetWorkflowsFiltered(ctx, lib.GetWorkflowsFilter{Channel: lib.ChannelTypeEmail}, 1, 10)
+
+	require.NoError(t, err)
+	require.Equal(t, &expectedResponse, resp)
+}
+
+func TestWorkflowService_GetWorkflowsCount_Success(t *testing.T) {
+	httpServer := createTestServer(t, TestServerOptions[io.Reader, lib.WorkflowListResponse]{
+		expectedURLPath:    "/v1/workflows?limit=1&page=0",
+		expectedSentMethod: http.MethodGet,
+		expectedSentBody:   http.NoBody,
+		responseStatusCode: http.StatusOK,
+		responseBody: lib.WorkflowListResponse{
+			PageSize:   1,
+			TotalCount: 1245,
+			Data:       []lib.Workflow{workflowResponse.Data},
+		},
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	count, err := c.WorkflowApi.GetWorkflowsCount(ctx)
+
+	require.NoError(t, err)
+	require.Equal(t, 1245, count)
+}
+
+func TestWorkflowService_GetWorkflowGroups_Success(t *testing.T) {
+	expectedResponse := lib.NotificationGroupsResponse{
+		Data: []lib.NotificationGroup{{Id: "groupId", Name: "General"}},
+	}
+
+	httpServer := createTestServer(t, TestServerOptions[io.Reader, lib.NotificationGroupsResponse]{
+		expectedURLPath:    "/v1/notification-groups",
+		expectedSentMethod: http.MethodGet,
+		expectedSentBody:   http.NoBody,
+		responseStatusCode: http.StatusOK,
+		responseBody:       expectedResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.WorkflowApi.GetWorkflowGroups(ctx)
+
+	require.NoError(t, err)
+	require.Equal(t, &expectedResponse, resp)
+}
+
+func TestWorkflowService_GetWorkflowsByNotificationGroup_Success(t *testing.T) {
+	expectedResponse := lib.WorkflowListResponse{
+		Page:       1,
+		PageSize:   10,
+		TotalCount: 1,
+		Data:       []lib.Workflow{workflowResponse.Data},
+	}
+
+	httpServer := createTestServer(t, TestServerOptions[io.Reader, lib.WorkflowListResponse]{
+		expectedURLPath:    "/v1/workflows?limit=10&notificationGroup=groupId&page=1",
+		expectedSentMethod: http.MethodGet,
+		expectedSentBody:   http.NoBody,
+		responseStatusCode: http.StatusOK,
+		responseBody:       expectedResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.WorkflowApi.GetWorkflowsByNotificationGroup(ctx, "groupId", 1, 10)
+
+	require.NoError(t, err)
+	require.Equal(t, &expectedResponse, resp)
+}
+
+func TestWorkflowService_UpdateWorkflowNotificationGroup_Success(t *testing.T) {
+	httpServer := createTestServer(t, TestServerOptions[map[string]interface{}, lib.WorkflowResponse]{
+		expectedURLPath:    fmt.Sprintf("/v1/workflows/%s", workflowId),
+		expectedSentMethod: http.MethodPut,
+		expectedSentBody:   map[string]interface{}{"notificationGroupId": "groupId"},
+		responseStatusCode: http.StatusOK,
+		responseBody:       workflowResponse,
+	})
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	resp, err := c.WorkflowApi.UpdateWorkflowNotificationGroup(ctx, workflowId, "groupId")
+
+	require.NoError(t, err)
+	require.Equal(t, &workflowResponse, resp)
+}
+
+func TestWorkflowService_GetWorkflowChangeHistory_Success(t *testing.T) {
+	pages := map[string]lib.ChangesGetResponse{
+		"/v1/changes?limit=2&page=1&promoted=true": {TotalCount: 3, Page: 1, PageSize: 2, Data: []lib.ChangesGetResponseData{
+			{Id: "first", EntityId: workflowId, CreatorId: "userId", Type: "NotificationTemplate", Change: map[string]interface{}{"name": "renamed"}, CreatedAt: "2023-04-02T10:00:00.000Z"},
+			{Id: "other", EntityId: "otherWorkflowId", Type: "NotificationTemplate", CreatedAt: "2023-04-01T10:00:00.000Z"},
+		}},
+		"/v1/changes?limit=2&page=2&promoted=true": {TotalCount: 3, Page: 2, PageSize: 2, Data: []lib.ChangesGetResponseData{
+			{Id: "second", EntityId: workflowId, CreatorId: "userId", Type: "NotificationTemplate", CreatedAt: "2023-04-01T09:00:00.000Z"},
+		}},
+	}
+
+	var requests []string
+	httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requests = append(requests, req.RequestURI)
+		page, ok := pages[req.RequestURI]
+		require.True(t, ok, req.RequestURI)
+		json.NewEncoder(w).Encode(page)
+	}))
+	defer httpServer.Close()
+
+	ctx := context.Background()
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(httpServer.URL)})
+	first, err := c.WorkflowApi.GetWorkflowChangeHistory(ctx, workflowId, true, lib.PageOptions{Limit: 2})
+
+	require.NoError(t, err)
+	require.Equal(t, []lib.WorkflowChange{{
+		ChangeId:   "first",
+		CreatedBy:  lib.UserRef{Id: "userId"},
+		CreatedAt:  time.Date(2023, 4, 2, 10, 0, 0, 0, time.UTC),
+		ChangeType: "NotificationTemplate",
+		Diff:       map[string]interface{}{"name": "renamed"},
+		Promoted:   true,
+	}}, first.Data)
+	require.True(t, first.HasMore)
+	require.Equal(t, 3, first.TotalCount)
+
+	second, err := c.WorkflowApi.GetWorkflowChangeHistory(ctx, workflowId, true, lib.PageOptions{FetchNextPage: first.NextCursor})
+
+	require.NoError(t, err)
+	require.Len(t, second.Data, 1)
+	require.Equal(t, "second", second.Data[0].ChangeId)
+	require.False(t, second.HasMore)
+	require.Empty(t, second.NextCursor)
+	require.Len(t, requests, 2)
+
+	_, err = c.WorkflowApi.GetWorkflowChangeHistory(ctx, workflowId, true, lib.PageOptions{FetchNextPage: "page=2&promoted=true"})
+
+	require.EqualError(t, err, `invalid change history cursor "page=2&promoted=true"`)
+	require.Len(t, requests, 2)
+}
+
+func TestWorkflowService_GetWorkflowDisabledSubscribers(t *testing.T) {
+	preferences := map[string]bool{"enabled": true, "disabled": false}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/v1/subscribers" {
+			require.Equal(t, "limit=10&page=1", req.URL.RawQuery)
+			json.NewEncoder(w).Encode(lib.SubscriberListResponse{
+				Page:       1,
+				PageSize:   10,
+				TotalCount: 13,
+				Data:       []lib.Subscriber{{SubscriberId: "enabled"}, {SubscriberId: "disabled"}, {SubscriberId: "default"}},
+			})
+			return
+		}
+
+		subscriberID := strings.TrimSuffix(strings.TrimPrefix(req.URL.Path, "/v1/subscribers/"), "/preferences")
+		resp := lib.SubscriberPreferencesResponse{Data: []lib.SubscriberPreference{{
+			Template:   lib.Template{ID: "otherWorkflow"},
+			Preference: lib.Preference{Enabled: false},
+		}}}
+		if enabled, ok := preferences[subscriberID]; ok {
+			resp.Data = append(resp.Data, lib.SubscriberPreference{
+				Template:   lib.Template{ID: workflowId},
+				Preference: lib.Preference{Enabled: enabled},
+			})
+		}
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(server.URL)})
+	resp, err := c.WorkflowApi.GetWorkflowDisabledSubscribers(context.Background(), workflowId, 1, 10)
+
+	require.NoError(t, err)
+	require.Equal(t, &lib.SubscriberListResponse{
+		Page:       1,
+		PageSize:   10,
+		TotalCount: 13,
+		Data:       []lib.Subscriber{{SubscriberId: "disabled"}},
+	}, resp)
+}
+
+func TestWorkflowService_GetWorkflowDisabledSubscribers_MissingId(t *testing.T) {
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{})
+	_, err := c.WorkflowApi.GetWorkflowDisabledSubscribers(context.Background(), "", 0, 10)
+
+	require.ErrorIs(t, err, lib.ErrMissingWorkflowId)
+}
+
+func TestWorkflowService_GetWorkflowChannelStats(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		query := req.URL.Query()
+		switch req.URL.Path {
+		case "/v1/notifications":
+			require.Equal(t, []string{workflowId}, query["templates"])
+			require.Equal(t, "2023-01-01T00:00:00Z", query.Get("after"))
+			if query.Get("page") != "1" {
+				json.NewEncoder(w).Encode(lib.NotificationListResponse{HasMore: true, Data: []lib.NotificationItem{{TransactionId: "t1"}, {TransactionId: "t2"}}})
+				return
+			}
+			json.NewEncoder(w).Encode(lib.NotificationListResponse{Data: []lib.NotificationItem{{TransactionId: "t3"}}})
+		case "/v1/messages":
+			var list lib.MessageListResponse
+			switch strings.Join(query["transactionId"], ",") {
+			case "t1,t2":
+				list.Data = []lib.Message{
+					{TemplateId: workflowId, Channel: lib.ChannelTypeEmail, Status: "sent"},
+					{TemplateId: workflowId, Channel: lib.ChannelTypeEmail, Status: "error"},
+					{TemplateId: workflowId, Channel: lib.ChannelTypeInApp, Status: "sent", Seen: true, Read: true},
+				}
+			case "t3":
+				list.Data = []lib.Message{
+					{TemplateId: workflowId, Channel: lib.ChannelTypeInApp, Status: "sent", Seen: true},
+					{TemplateId: "otherWorkflow", Channel: lib.ChannelTypeSMS, Status: "sent"},
+				}
+			}
+			json.NewEncoder(w).Encode(list)
+		default:
+			t.Errorf("unexpected request %s", req.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(server.URL)})
+	opts := lib.WorkflowChannelStatsOptions{After: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)}
+	stats, err := c.WorkflowApi.GetWorkflowChannelStats(context.Background(), workflowId, opts)
+
+	require.NoError(t, err)
+	require.Equal(t, &lib.WorkflowChannelStats{
+		Total:         map[lib.ChannelType]int{lib.ChannelTypeEmail: 2, lib.ChannelTypeInApp: 2},
+		Sent:          map[lib.ChannelType]int{lib.ChannelTypeEmail: 1, lib.ChannelTypeInApp: 2},
+		Failed:        map[lib.ChannelType]int{lib.ChannelTypeEmail: 1},
+		Seen:          map[lib.ChannelType]int{lib.ChannelTypeInApp: 2},
+		Read:          map[lib.ChannelType]int{lib.ChannelTypeInApp: 1},
+		Notifications: 3,
+	}, stats)
+
+	opts.MaxNotifications = 2
+	stats, err = c.WorkflowApi.GetWorkflowChannelStats(context.Background(), workflowId, opts)
+
+	require.NoError(t, err)
+	require.Equal(t, 2, stats.Notifications)
+	require.True(t, stats.Truncated)
+	require.Equal(t, map[lib.ChannelType]int{lib.ChannelTypeEmail: 2, lib.ChannelTypeInApp: 1}, stats.Total)
+}
+
+func TestWorkflowService_BulkUpdateWorkflowStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		require.Equal(t, http.MethodPut, req.Method)
+		var body lib.UpdateWorkflowStatusRequest
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+		require.False(t, body.Active)
+
+		if req.URL.Path == "/v1/workflows/missing/status" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"statusCode":404,"message":"Workflow not found"}`))
+			return
+		}
+		json.NewEncoder(w).Encode(workflowResponse)
+	}))
+	defer server.Close()
+
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL(server.URL)})
+	result, err := c.WorkflowApi.BulkUpdateWorkflowStatus(context.Background(), []string{"first", "missing", "second"}, false)
+
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"first", "second"}, result.Updated)
+	require.Len(t, result.Errors, 1)
+	require.Equal(t, "missing", result.Errors[0].WorkflowID)
+	require.Contains(t, result.Errors[0].Error, "status code 404")
+}
+
+func TestWorkflowService_BulkUpdateWorkflowStatus_CanceledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	c := lib.NewAPIClient(novuApiKey, &lib.Config{BackendURL: lib.MustParseURL("http://127.0.0.1:0")})
+	result, err := c.WorkflowApi.BulkUpdateWorkflowStatus(ctx, []string{"first", "second"}, true)
+
+	require.NoError(t, err)
+	require.Empty(t, result.Updated)
+	require.Len(t, result.Errors, 2)
+}
@@ -0,0 +1,119 @@
+// Package metrics exposes Prometheus metrics for the requests sent by the Novu
+// client.
+package metrics
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/novuhq/go-novu/lib"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// NovuCollector is a prometheus.Collector tracking the requests made through
+// its Middleware.
+type NovuCollector struct {
+	requests *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+	basePath string
+}
+
+var _ prometheus.Collector = &NovuCollector{}
+
+// NewNovuCollector returns a collector for a client configured with backendURL,
+// the lib.Config.BackendURL, nil for the default one. Its path is left out of
+// the endpoint label.
+func NewNovuCollector(backendURL *url.URL) *NovuCollector {
+	var basePath string
+	if backendURL != nil {
+		basePath = strings.Trim(backendURL.Path, "/")
+	}
+
+	return &NovuCollector{
+		basePath: basePath,
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "novu_requests_total",
+			Help: "Number of requests sent to the Novu API.",
+		}, []string{"method", "endpoint", "status_code"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "novu_request_duration_seconds",
+			Help:    "Duration of requests sent to the Novu API.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"endpoint"}),
+	}
+}
+
+// WithPrometheusCollector registers a new NovuCollector with reg and returns
+// the middleware feeding it, to be added to lib.Config.Middlewares. backendURL
+// is the lib.Config.BackendURL of that client, nil for the default one.
+func WithPrometheusCollector(reg prometheus.Registerer, backendURL *url.URL) (lib.Middleware, error) {
+	collector := NewNovuCollector(backendURL)
+	if err := reg.Register(collector); err != nil {
+		return nil, err
+	}
+
+	return collector.Middleware(), nil
+}
+
+func (n *NovuCollector) Describe(ch chan<- *prometheus.Desc) {
+	n.requests.Describe(ch)
+	n.duration.Describe(ch)
+}
+
+func (n *NovuCollector) Collect(ch chan<- prometheus.Metric) {
+	n.requests.Collect(ch)
+	n.duration.Collect(ch)
+}
+
+// Middleware observes every response. Requests failing without a response are
+// counted with the "error" status code.
+func (n *NovuCollector) Middleware() lib.Middleware {
+	return func(req *http.Request, next http.RoundTripper) (*http.Response, error) {
+		start := time.Now()
+		res, err := next.RoundTrip(req)
+
+		status := "error"
+		if err == nil {
+			status = strconv.Itoa(res.StatusCode)
+		}
+
+		ep := n.endpoint(req)
+		n.requests.WithLabelValues(req.Method, ep, status).Inc()
+		n.duration.WithLabelValues(ep).Observe(time.Since(start).Seconds())
+
+		return res, err
+	}
+}
+
+// endpoint keeps the version and resource of the path below the BackendURL,
+// e.g. /v1/subscribers for /v1/subscribers/123/preferences, so ids do not blow
+// up label cardinality.
+func (n *NovuCollector) endpoint(req *http.Request) string {
+	path := strings.Trim(req.URL.Path, "/")
+	if n.basePath != "" && (path == n.basePath || strings.HasPrefix(path, n.basePath+"/")) {
+		path = strings.TrimPrefix(strings.TrimPrefix(path, n.basePath), "/")
+	}
+
+	segments := strings.Split(path, "/")
+	keep := 1
+	if isVersion(segments[0]) {
+		keep = 2
+	}
+	if len(segments) > keep {
+		segments = segments[:keep]
+	}
+
+	return "/" + strings.Join(segments, "/")
+}
+
+// isVersion reports whether the path segment is an API version such as v1.
+func isVersion(segment string) bool {
+	if len(segment) < 2 || segment[0] != 'v' {
+		return false
+	}
+	_, err := strconv.Atoi(segment[1:])
+	return err == nil
+}
@@ -0,0 +1,79 @@
+package metrics_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/novuhq/go-novu/lib"
+	"github.com/novuhq/go-novu/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithPrometheusCollector(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"data":{}}`))
+	}))
+	defer server.Close()
+
+	reg := prometheus.NewPedanticRegistry()
+	mw, err := metrics.WithPrometheusCollector(reg, nil)
+	require.NoError(t, err)
+
+	c := lib.NewAPIClient("api-key", &lib.Config{
+		BackendURL:  lib.MustParseURL(server.URL),
+		Middlewares: []lib.Middleware{mw},
+	})
+	_, err = c.SubscriberApi.Get(context.Background(), "subscriberId")
+	require.NoError(t, err)
+	_, err = c.SubscriberApi.Get(context.Background(), "otherSubscriberId")
+	require.NoError(t, err)
+
+	expected := `
+# HELP novu_requests_total Number of requests sent to the Novu API.
+# TYPE novu_requests_total counter
+novu_requests_total{endpoint="/v1/subscribers",method="GET",status_code="200"} 2
+`
+	assert.NoError(t, testutil.GatherAndCompare(reg, strings.NewReader(expected), "novu_requests_total"))
+	count, err := testutil.GatherAndCount(reg, "novu_request_duration_seconds")
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	_, err = metrics.WithPrometheusCollector(reg, nil)
+	assert.Error(t, err)
+}
+
+func TestWithPrometheusCollector_PrefixedBackendURL(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		paths = append(paths, req.URL.Path)
+		w.Write([]byte(`{"data":[]}`))
+	}))
+	defer server.Close()
+
+	backendURL := lib.MustParseURL(server.URL + "/novu/api")
+	reg := prometheus.NewPedanticRegistry()
+	mw, err := metrics.WithPrometheusCollector(reg, backendURL)
+	require.NoError(t, err)
+
+	c := lib.NewAPIClient("api-key", &lib.Config{BackendURL: backendURL, Middlewares: []lib.Middleware{mw}})
+	_, err = c.SubscriberApi.Get(context.Background(), "subscriberId")
+	require.NoError(t, err)
+	_, err = c.TopicsApi.List(context.Background(), nil)
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"/novu/api/v1/subscribers/subscriberId", "/novu/api/v1/topics"}, paths)
+	expected := `
+# HELP novu_requests_total Number of requests sent to the Novu API.
+# TYPE novu_requests_total counter
+novu_requests_total{endpoint="/v1/subscribers",method="GET",status_code="200"} 1
+novu_requests_total{endpoint="/v1/topics",method="GET",status_code="200"} 1
+`
+	assert.NoError(t, testutil.GatherAndCompare(reg, strings.NewReader(expected), "novu_requests_total"))
+}
@@ -0,0 +1,58 @@
+//go:build otel
+
+// Package novuotel traces Novu API calls with OpenTelemetry. It is only built
+// with the otel build tag so the client itself does not depend on OpenTelemetry:
+//
+//	go build -tags otel ./...
+package novuotel
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/novuhq/go-novu/lib"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/novuhq/go-novu/otel"
+
+// WithOtelTracerProvider returns a middleware that wraps every request in a client
+// span named novu.http.<METHOD> and injects the W3C trace context headers.
+// Add it to lib.Config.Middlewares.
+func WithOtelTracerProvider(tp trace.TracerProvider) lib.Middleware {
+	tracer := tp.Tracer(instrumentationName)
+	propagator := propagation.TraceContext{}
+
+	return func(req *http.Request, next http.RoundTripper) (*http.Response, error) {
+		ctx, span := tracer.Start(req.Context(), fmt.Sprintf("novu.http.%s", req.Method),
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(
+				semconv.HTTPRequestMethodKey.String(req.Method),
+				semconv.URLFull(req.URL.String()),
+				semconv.ServerAddress(req.URL.Hostname()),
+			),
+		)
+		defer span.End()
+
+		req = req.WithContext(ctx)
+		propagator.Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+		res, err := next.RoundTrip(req)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return res, err
+		}
+
+		span.SetAttributes(attribute.Int(string(semconv.HTTPResponseStatusCodeKey), res.StatusCode))
+		if res.StatusCode >= http.StatusBadRequest {
+			span.SetStatus(codes.Error, http.StatusText(res.StatusCode))
+		}
+
+		return res, nil
+	}
+}
@@ -0,0 +1,51 @@
+//go:build otel
+
+package novuotel_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/novuhq/go-novu/lib"
+	novuotel "github.com/novuhq/go-novu/otel"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestWithOtelTracerProvider(t *testing.T) {
+	var traceparent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		traceparent = req.Header.Get("traceparent")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"statusCode":404,"message":"Subscriber not found"}`))
+	}))
+	defer server.Close()
+
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	c := lib.NewAPIClient("api-key", &lib.Config{
+		BackendURL:  lib.MustParseURL(server.URL),
+		Middlewares: []lib.Middleware{novuotel.WithOtelTracerProvider(tp)},
+	})
+	_, err := c.SubscriberApi.Get(context.Background(), "subscriberId")
+	require.Error(t, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	assert.Equal(t, "novu.http.GET", spans[0].Name())
+	assert.Equal(t, codes.Error, spans[0].Status().Code)
+	assert.Contains(t, traceparent, spans[0].SpanContext().TraceID().String())
+
+	attrs := map[string]string{}
+	for _, kv := range spans[0].Attributes() {
+		attrs[string(kv.Key)] = kv.Value.Emit()
+	}
+	assert.Equal(t, "GET", attrs["http.request.method"])
+	assert.Equal(t, "404", attrs["http.response.status_code"])
+}
@@ -0,0 +1,172 @@
+// Package testutil provides an in-memory Novu API for testing code that uses
+// the go-novu client without reaching the real API.
+package testutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/novuhq/go-novu/lib"
+)
+
+// ExpectedRequest describes a request the mock server should receive and the
+// canned response it answers with.
+type ExpectedRequest struct {
+	method string
+	path   string
+	match  func(body map[string]interface{}) bool
+
+	statusCode int
+	response   interface{}
+	calls      int
+}
+
+// Respond sets the status code and body returned when the request is matched.
+func (e *ExpectedRequest) Respond(statusCode int, body interface{}) *ExpectedRequest {
+	e.statusCode = statusCode
+	e.response = body
+	return e
+}
+
+func (e *ExpectedRequest) String() string {
+	return fmt.Sprintf("%s %s", e.method, e.path)
+}
+
+// NovuMockServer is an httptest server that answers registered expectations
+// and records every request that did not match one.
+type NovuMockServer struct {
+	*httptest.Server
+
+	mu           sync.Mutex
+	expectations []*ExpectedRequest
+	unexpected   []string
+}
+
+func NewNovuMockServer() *NovuMockServer {
+	m := &NovuMockServer{}
+	m.Server = httptest.NewServer(http.HandlerFunc(m.handle))
+	return m
+}
+
+// Config returns a client configuration pointing at the mock server.
+func (m *NovuMockServer) Config() *lib.Config {
+	return &lib.Config{BackendURL: lib.MustParseURL(m.URL)}
+}
+
+// Expect registers a request by method and path, relative to the API version,
+// e.g. Expect(http.MethodGet, "/subscribers/subscriberId").
+func (m *NovuMockServer) Expect(method string, path string) *ExpectedRequest {
+	e := &ExpectedRequest{
+		method:     method,
+		path:       "/" + lib.Version + path,
+		statusCode: http.StatusOK,
+		response:   map[string]interface{}{"data": map[string]interface{}{}},
+	}
+
+	m.mu.Lock()
+	m.expectations = append(m.expectations, e)
+	m.mu.Unlock()
+
+	return e
+}
+
+// ExpectTrigger registers a trigger of the given workflow. When payload is not
+// nil the request payload must equal it once both are encoded as JSON.
+func (m *NovuMockServer) ExpectTrigger(workflowID string, payload interface{}) *ExpectedRequest {
+	var expectedPayload interface{}
+	if payload != nil {
+		expectedPayload = normalize(payload)
+	}
+
+	e := m.Expect(http.MethodPost, "/events/trigger")
+	e.match = func(body map[string]interface{}) bool {
+		if body["name"] != workflowID {
+			return false
+		}
+		return payload == nil || reflect.DeepEqual(body["payload"], expectedPayload)
+	}
+
+	return e.Respond(http.StatusCreated, lib.EventResponse{
+		Data: lib.EventResponseData{Acknowledged: true, Status: "processed"},
+	})
+}
+
+// AssertExpectations fails the test for every expectation that was never
+// matched and every request that matched none.
+func (m *NovuMockServer) AssertExpectations(t testing.TB) {
+	t.Helper()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, e := range m.expectations {
+		if e.calls == 0 {
+			t.Errorf("novu mock: expected request %s was not received", e)
+		}
+	}
+	for _, r := range m.unexpected {
+		t.Errorf("novu mock: unexpected request %s", r)
+	}
+}
+
+func (m *NovuMockServer) handle(w http.ResponseWriter, r *http.Request) {
+	raw, _ := io.ReadAll(r.Body)
+
+	var body map[string]interface{}
+	_ = json.Unmarshal(raw, &body)
+
+	m.mu.Lock()
+	e := m.find(r.Method, r.URL.Path, body)
+	if e == nil {
+		m.unexpected = append(m.unexpected, fmt.Sprintf("%s %s %s", r.Method, r.URL.RequestURI(), raw))
+	} else {
+		e.calls++
+	}
+	m.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	if e == nil {
+		w.WriteHeader(http.StatusNotFound)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"statusCode": http.StatusNotFound,
+			"message":    "no expectation matches " + r.Method + " " + r.URL.Path,
+		})
+		return
+	}
+
+	w.WriteHeader(e.statusCode)
+	if e.response != nil {
+		_ = json.NewEncoder(w).Encode(e.response)
+	}
+}
+
+// find prefers expectations that have not been matched yet, so the same
+// request can be expected several times with different responses.
+func (m *NovuMockServer) find(method string, path string, body map[string]interface{}) *ExpectedRequest {
+	var matched *ExpectedRequest
+	for _, e := range m.expectations {
+		if e.method != method || e.path != path || (e.match != nil && !e.match(body)) {
+			continue
+		}
+		if e.calls == 0 {
+			return e
+		}
+		if matched == nil {
+			matched = e
+		}
+	}
+	return matched
+}
+
+func normalize(v interface{}) interface{} {
+	var out interface{}
+	b, _ := json.Marshal(v)
+	_ = json.Unmarshal(b, &out)
+	return out
+}
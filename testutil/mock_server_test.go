@@ -0,0 +1,76 @@
+package testutil_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/novuhq/go-novu/lib"
+	"github.com/novuhq/go-novu/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNovuMockServer_ExpectTrigger(t *testing.T) {
+	server := testutil.NewNovuMockServer()
+	defer server.Close()
+
+	server.ExpectTrigger("welcome", map[string]interface{}{"name": "John"})
+
+	c := lib.NewAPIClient("api-key", server.Config())
+	resp, err := c.EventApi.Trigger(context.Background(), "welcome", lib.ITriggerPayloadOptions{
+		To:      "subscriberId",
+		Payload: map[string]interface{}{"name": "John"},
+	})
+
+	require.NoError(t, err)
+	assert.True(t, resp.Data.Acknowledged)
+	server.AssertExpectations(t)
+}
+
+func TestNovuMockServer_CannedResponse(t *testing.T) {
+	server := testutil.NewNovuMockServer()
+	defer server.Close()
+
+	server.Expect(http.MethodGet, "/subscribers/subscriberId").
+		Respond(http.StatusNotFound, map[string]interface{}{"statusCode": 404, "message": "Subscriber not found"})
+
+	c := lib.NewAPIClient("api-key", server.Config())
+	_, err := c.SubscriberApi.Get(context.Background(), "subscriberId")
+
+	var apiErr *lib.NovuAPIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, "Subscriber not found", apiErr.Message)
+	server.AssertExpectations(t)
+}
+
+func TestNovuMockServer_ReportsMismatches(t *testing.T) {
+	server := testutil.NewNovuMockServer()
+	defer server.Close()
+
+	server.ExpectTrigger("welcome", map[string]interface{}{"name": "John"})
+
+	c := lib.NewAPIClient("api-key", server.Config())
+	_, err := c.EventApi.Trigger(context.Background(), "welcome", lib.ITriggerPayloadOptions{
+		To:      "subscriberId",
+		Payload: map[string]interface{}{"name": "Jane"},
+	})
+	require.Error(t, err)
+
+	recorder := &recordingTB{TB: t}
+	server.AssertExpectations(recorder)
+	assert.Len(t, recorder.errors, 2)
+}
+
+type recordingTB struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recordingTB) Helper() {}
+
+func (r *recordingTB) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,27 @@
+package webhooks
+
+import "time"
+
+// WebhookEventType identifies the event a webhook was sent for.
+type WebhookEventType string
+
+const (
+	WebhookEventNotificationSent   WebhookEventType = "notification.sent"
+	WebhookEventNotificationFailed WebhookEventType = "notification.failed"
+	WebhookEventNotificationSeen   WebhookEventType = "notification.seen"
+	WebhookEventNotificationRead   WebhookEventType = "notification.read"
+	WebhookEventSubscriberCreated  WebhookEventType = "subscriber.created"
+	WebhookEventSubscriberUpdated  WebhookEventType = "subscriber.updated"
+	WebhookEventSubscriberDeleted  WebhookEventType = "subscriber.deleted"
+	WebhookEventWorkflowCreated    WebhookEventType = "workflow.created"
+	WebhookEventWorkflowUpdated    WebhookEventType = "workflow.updated"
+	WebhookEventWorkflowDeleted    WebhookEventType = "workflow.deleted"
+)
+
+// WebhookPayload is the body of a webhook. Data depends on EventType and is
+// decoded as generic JSON; re-marshal it into a concrete type when needed.
+type WebhookPayload struct {
+	EventType WebhookEventType `json:"type"`
+	CreatedAt time.Time        `json:"createdAt"`
+	Data      interface{}      `json:"data"`
+}
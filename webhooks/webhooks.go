@@ -0,0 +1,44 @@
+// Package webhooks verifies and decodes the webhooks sent by Novu.
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+)
+
+// SignatureHeader is the header carrying the signature of a webhook body.
+const SignatureHeader = "X-Novu-Signature"
+
+// SignatureVerificationError is returned when a webhook signature does not
+// match its body.
+type SignatureVerificationError struct {
+	Reason string
+}
+
+func (e *SignatureVerificationError) Error() string {
+	return fmt.Sprintf("webhook signature verification failed, %s", e.Reason)
+}
+
+// VerifyWebhookSignature checks that signature, the hex encoded value of the
+// X-Novu-Signature header, is the HMAC-SHA256 of body keyed with secret. body
+// must be the raw request body, before any decoding.
+func VerifyWebhookSignature(secret string, body []byte, signature string) error {
+	if signature == "" {
+		return &SignatureVerificationError{Reason: "missing signature"}
+	}
+
+	got, err := hex.DecodeString(signature)
+	if err != nil {
+		return &SignatureVerificationError{Reason: "signature is not hex encoded"}
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	if !hmac.Equal(got, mac.Sum(nil)) {
+		return &SignatureVerificationError{Reason: "signature does not match body"}
+	}
+
+	return nil
+}
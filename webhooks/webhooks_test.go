@@ -0,0 +1,62 @@
+package webhooks_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/novuhq/go-novu/webhooks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const secret = "whsec_test"
+
+var body = []byte(`{"type":"message.sent","data":{"messageId":"messageId"}}`)
+
+func sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestVerifyWebhookSignature_Valid(t *testing.T) {
+	assert.NoError(t, webhooks.VerifyWebhookSignature(secret, body, sign(secret, body)))
+}
+
+func TestVerifyWebhookSignature_Invalid(t *testing.T) {
+	tests := map[string]struct {
+		body      []byte
+		signature string
+		reason    string
+	}{
+		"missing":       {body: body, signature: "", reason: "missing signature"},
+		"not hex":       {body: body, signature: "not-hex", reason: "signature is not hex encoded"},
+		"wrong secret":  {body: body, signature: sign("other", body), reason: "signature does not match body"},
+		"modified body": {body: []byte(`{"type":"message.sent"}`), signature: sign(secret, body), reason: "signature does not match body"},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := webhooks.VerifyWebhookSignature(secret, tt.body, tt.signature)
+
+			var verificationErr *webhooks.SignatureVerificationError
+			require.True(t, errors.As(err, &verificationErr))
+			assert.Equal(t, tt.reason, verificationErr.Reason)
+		})
+	}
+}
+
+func TestWebhookPayload_Unmarshal(t *testing.T) {
+	raw := []byte(`{"type":"notification.failed","createdAt":"2024-03-01T10:00:00Z","data":{"transactionId":"txn"}}`)
+
+	var payload webhooks.WebhookPayload
+	require.NoError(t, json.Unmarshal(raw, &payload))
+
+	assert.Equal(t, webhooks.WebhookEventNotificationFailed, payload.EventType)
+	assert.True(t, time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC).Equal(payload.CreatedAt))
+	assert.Equal(t, map[string]interface{}{"transactionId": "txn"}, payload.Data)
+}